@@ -31,7 +31,7 @@
           pname = "gcs-emulator";
           version = "0.0.1";
           src = ./.;
-          vendorHash = "sha256-ddkm24y+YP5AKd3P8JrHESl8zUSnJtefK0oSvOab2Ag=";
+          vendorHash = "sha256-CDcXU/i5m5DusCvL9HBy+LECZeWvQvQiV9EKf/z7Mog=";
           doCheck = false;
         };
 
@@ -0,0 +1,167 @@
+// Package gcstest runs the emulator in-process for use in tests, backed by
+// in-memory stores.
+package gcstest
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	chunkmemory "github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	metamemory "github.com/cbrewster/gcs-emulator/internal/metastore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+	"github.com/cbrewster/gcs-emulator/internal/server"
+)
+
+// Options configure a test server.
+type Options struct {
+	// Buckets are created, along with their objects, before the server starts
+	// serving requests.
+	Buckets []Bucket
+
+	// GRPC also serves the gRPC API, and makes the server's client use it.
+	GRPC bool
+}
+
+// Bucket is a bucket to seed the server with.
+type Bucket struct {
+	Name       string
+	Versioning bool
+	Objects    []Object
+}
+
+// Object is an object to seed a bucket with.
+type Object struct {
+	Name        string
+	Contents    []byte
+	ContentType string
+	Metadata    map[string]string
+}
+
+// Server is an emulator serving the GCS JSON API over HTTP, and optionally
+// the gRPC API.
+type Server struct {
+	srv      *httptest.Server
+	grpcAddr string
+	client   *storage.Client
+}
+
+// NewServer starts a server seeded according to options. The server and its
+// client are closed when the test finishes.
+func NewServer(t testing.TB, options Options) *Server {
+	t.Helper()
+
+	store := objectstore.New(metamemory.New(), chunkmemory.New())
+	for _, b := range options.Buckets {
+		err := seedBucket(store, b)
+		if err != nil {
+			t.Fatalf("gcstest: seed bucket %s: %v", b.Name, err)
+		}
+	}
+
+	s := &Server{srv: httptest.NewServer(server.New(store))}
+	t.Cleanup(s.srv.Close)
+
+	var client *storage.Client
+	var err error
+	if options.GRPC {
+		var lis net.Listener
+		lis, err = net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("gcstest: listen for gRPC: %v", err)
+		}
+		s.grpcAddr = lis.Addr().String()
+
+		grpcSrv := grpcserver.New(store)
+		go grpcSrv.Serve(lis)
+		t.Cleanup(grpcSrv.Stop)
+
+		client, err = storage.NewGRPCClient(
+			context.Background(),
+			option.WithEndpoint(s.grpcAddr),
+			option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+			option.WithoutAuthentication(),
+		)
+	} else {
+		client, err = storage.NewClient(
+			context.Background(),
+			option.WithEndpoint(s.srv.URL+"/storage/v1/"),
+			option.WithoutAuthentication(),
+			storage.WithJSONReads(),
+		)
+	}
+	if err != nil {
+		t.Fatalf("gcstest: create storage client: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+	})
+	s.client = client
+
+	return s
+}
+
+func seedBucket(store *objectstore.Store, b Bucket) error {
+	bucket, err := store.CreateBucket(b.Name, metastore.NewBucketOptions{
+		Versioning: b.Versioning,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, o := range b.Objects {
+		w, err := bucket.Object(o.Name).NewWriter()
+		if err != nil {
+			return err
+		}
+		w.Attrs = metastore.ObjectAttrs{
+			ContentType: o.ContentType,
+			Metadata:    o.Metadata,
+		}
+
+		_, err = w.Write(o.Contents)
+		if err != nil {
+			w.Abort()
+			return err
+		}
+
+		err = w.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Client returns a client which is configured to talk to the server.
+func (s *Server) Client() *storage.Client {
+	return s.client
+}
+
+// URL returns the base URL of the server, e.g. "http://127.0.0.1:1234".
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Host returns the address of the server, suitable for the
+// STORAGE_EMULATOR_HOST environment variable.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.srv.URL, "http://")
+}
+
+// GRPCHost returns the address the gRPC API is served on, suitable for the
+// STORAGE_EMULATOR_HOST_GRPC environment variable. It's empty unless
+// Options.GRPC is set.
+func (s *Server) GRPCHost() string {
+	return s.grpcAddr
+}
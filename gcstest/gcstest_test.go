@@ -0,0 +1,116 @@
+package gcstest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/shoenig/test/must"
+	"google.golang.org/api/iterator"
+
+	"github.com/cbrewster/gcs-emulator/gcstest"
+)
+
+func TestServer(t *testing.T) {
+	srv := gcstest.NewServer(t, gcstest.Options{
+		Buckets: []gcstest.Bucket{{
+			Name: "my-bucket",
+			Objects: []gcstest.Object{{
+				Name:        "seeded.txt",
+				Contents:    []byte("hello world"),
+				ContentType: "text/plain",
+				Metadata:    map[string]string{"foo": "bar"},
+			}},
+		}},
+	})
+
+	ctx := context.Background()
+	bucket := srv.Client().Bucket("my-bucket")
+
+	attrs, err := bucket.Object("seeded.txt").Attrs(ctx)
+	must.NoError(t, err)
+	must.Eq(t, "text/plain", attrs.ContentType)
+	must.Eq(t, map[string]string{"foo": "bar"}, attrs.Metadata)
+	must.Eq(t, 11, attrs.Size)
+
+	r, err := bucket.Object("seeded.txt").NewReader(ctx)
+	must.NoError(t, err)
+	read, err := io.ReadAll(r)
+	must.NoError(t, err)
+	must.NoError(t, r.Close())
+	must.Eq(t, "hello world", string(read))
+
+	w := bucket.Object("written.txt").NewWriter(ctx)
+	_, err = io.WriteString(w, "written by the client")
+	must.NoError(t, err)
+	must.NoError(t, w.Close())
+
+	r, err = bucket.Object("written.txt").NewRangeReader(ctx, 11, -1)
+	must.NoError(t, err)
+	read, err = io.ReadAll(r)
+	must.NoError(t, err)
+	must.NoError(t, r.Close())
+	must.Eq(t, "the client", string(read))
+
+	copier := bucket.Object("copied.txt").CopierFrom(bucket.Object("seeded.txt"))
+	copier.ContentType = "text/html"
+	attrs, err = copier.Run(ctx)
+	must.NoError(t, err)
+	must.Eq(t, "text/html", attrs.ContentType)
+	must.Eq(t, 11, attrs.Size)
+
+	var names []string
+	it := bucket.Objects(ctx, nil)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		must.NoError(t, err)
+		names = append(names, attrs.Name)
+	}
+	must.Eq(t, []string{"copied.txt", "seeded.txt", "written.txt"}, names)
+
+	err = bucket.Object("missing").Delete(ctx)
+	must.ErrorIs(t, err, storage.ErrObjectNotExist)
+}
+
+func TestGRPCServer(t *testing.T) {
+	srv := gcstest.NewServer(t, gcstest.Options{
+		Buckets: []gcstest.Bucket{{
+			Name: "my-bucket",
+			Objects: []gcstest.Object{{
+				Name:        "seeded.txt",
+				Contents:    []byte("hello world"),
+				ContentType: "text/plain",
+			}},
+		}},
+		GRPC: true,
+	})
+	must.NotEq(t, "", srv.GRPCHost())
+
+	ctx := context.Background()
+	bucket := srv.Client().Bucket("my-bucket")
+
+	r, err := bucket.Object("seeded.txt").NewRangeReader(ctx, 6, -1)
+	must.NoError(t, err)
+	read, err := io.ReadAll(r)
+	must.NoError(t, err)
+	must.NoError(t, r.Close())
+	must.Eq(t, "world", string(read))
+	must.Eq(t, "text/plain", r.Attrs.ContentType)
+
+	w := bucket.Object("written.txt").NewWriter(ctx)
+	_, err = io.WriteString(w, "written over gRPC")
+	must.NoError(t, err)
+	must.NoError(t, w.Close())
+
+	attrs, err := bucket.Object("written.txt").Attrs(ctx)
+	must.NoError(t, err)
+	must.Eq(t, 17, attrs.Size)
+
+	err = bucket.Object("missing").Delete(ctx)
+	must.ErrorIs(t, err, storage.ErrObjectNotExist)
+}
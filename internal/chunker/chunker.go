@@ -0,0 +1,241 @@
+// Package chunker splits streams of data into content-defined chunks using
+// FastCDC, so that identical regions of similar objects are stored once in
+// the content-addressed chunk store.
+package chunker
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// Options control the size of chunks.
+type Options struct {
+	// MinSize and MaxSize bound the size of chunks, except the last chunk of a
+	// stream which may be smaller than MinSize.
+	MinSize int
+	// AvgSize is the size chunks are normalized around.
+	AvgSize int
+	MaxSize int
+}
+
+// DefaultOptions keep chunks large enough that object metadata stays small
+// while still deduplicating well.
+var DefaultOptions = Options{
+	MinSize: 256 << 10,
+	AvgSize: 1 << 20,
+	MaxSize: 4 << 20,
+}
+
+// FixedSize returns options which split data into chunks of exactly size
+// bytes, apart from the last chunk. Fixed-size chunks make offsets within an
+// object cheap to locate, but don't deduplicate data which has shifted.
+func FixedSize(size int) Options {
+	return Options{MinSize: size, AvgSize: size, MaxSize: size}
+}
+
+// Validate checks that the sizes are consistent.
+func (o *Options) Validate() error {
+	if o.MinSize <= 0 || o.MinSize > o.AvgSize || o.AvgSize > o.MaxSize {
+		return fmt.Errorf("chunk sizes must satisfy 0 < min (%d) <= avg (%d) <= max (%d)", o.MinSize, o.AvgSize, o.MaxSize)
+	}
+	return nil
+}
+
+// Stats count the data written through a Chunker.
+type Stats struct {
+	Chunks int64
+	Bytes  int64
+	// DuplicateChunks and DuplicateBytes count chunks which were already in
+	// the chunk store when written.
+	DuplicateChunks int64
+	DuplicateBytes  int64
+}
+
+// DedupRatio is the ratio of bytes written to bytes which needed storing.
+func (s Stats) DedupRatio() float64 {
+	stored := s.Bytes - s.DuplicateBytes
+	if stored == 0 {
+		return 1
+	}
+	return float64(s.Bytes) / float64(stored)
+}
+
+func (s *Stats) add(other Stats) {
+	s.Chunks += other.Chunks
+	s.Bytes += other.Bytes
+	s.DuplicateChunks += other.DuplicateChunks
+	s.DuplicateBytes += other.DuplicateBytes
+}
+
+// Chunker creates writers which split data into chunks in a chunk store.
+type Chunker struct {
+	store   chunkstore.Store
+	options Options
+
+	mu    sync.Mutex
+	stats Stats
+}
+
+// New returns a chunker which writes to store. The options must be valid.
+func New(store chunkstore.Store, options Options) *Chunker {
+	return &Chunker{
+		store:   store,
+		options: options,
+	}
+}
+
+// Stats returns the totals over every writer closed so far.
+func (c *Chunker) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// write stores data as a new chunk.
+func (c *Chunker) write(data []byte) (chunkstore.ChunkHash, chunkstore.Checksums, error) {
+	writer, err := c.store.NewWriter()
+	if err != nil {
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, err
+	}
+
+	_, err = writer.Write(data)
+	if err != nil {
+		writer.Abort()
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, err
+	}
+
+	return writer.Close()
+}
+
+func (c *Chunker) NewWriter() *Writer {
+	return &Writer{
+		chunker:      c,
+		md5Hasher:    md5.New(),
+		crc32cHasher: crc32c.New(),
+	}
+}
+
+// Writer splits the data written to it into chunks. Chunks are written to the
+// chunk store as soon as their boundaries are known, at most MaxSize bytes
+// are buffered.
+type Writer struct {
+	chunker      *Chunker
+	buf          []byte
+	chunks       []metastore.Chunk
+	md5Hasher    hash.Hash
+	crc32cHasher hash.Hash32
+	stats        Stats
+	closed       bool
+}
+
+// Write implements io.Writer.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+
+	w.md5Hasher.Write(p)
+	w.crc32cHasher.Write(p)
+
+	// Data is buffered at most MaxSize bytes at a time, so each flush only
+	// moves what's left of a single chunk's worth of data.
+	written := len(p)
+	for len(p) > 0 {
+		n := min(len(p), w.chunker.options.MaxSize-len(w.buf))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+
+		if len(w.buf) == w.chunker.options.MaxSize {
+			err := w.flush()
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// flush writes the first chunk in the buffer to the chunk store.
+func (w *Writer) flush() error {
+	n := w.chunker.options.cut(w.buf)
+	data := w.buf[:n]
+
+	// Chunks which are already stored are only touched, which refreshes their
+	// modification time so the garbage collector doesn't delete them before
+	// they're referenced.
+	chunkHash := sha256.Sum256(data)
+	var crc uint32
+	err := w.chunker.store.Touch(chunkHash)
+	duplicate := err == nil
+	switch {
+	case duplicate:
+		crc = crc32c.Checksum(data)
+	case errors.Is(err, fs.ErrNotExist):
+		var checksums chunkstore.Checksums
+		chunkHash, checksums, err = w.chunker.write(data)
+		if err != nil {
+			return err
+		}
+		crc = checksums.CRC32C
+	default:
+		return err
+	}
+
+	w.chunks = append(w.chunks, metastore.Chunk{Hash: chunkHash, Size: int64(n), CRC32C: crc})
+	w.stats.Chunks++
+	w.stats.Bytes += int64(n)
+	if duplicate {
+		w.stats.DuplicateChunks++
+		w.stats.DuplicateBytes += int64(n)
+	}
+
+	w.buf = append(w.buf[:0], w.buf[n:]...)
+	return nil
+}
+
+// Close writes any buffered data and returns the chunks making up everything
+// written along with its checksums. Writing nothing results in a single empty
+// chunk.
+func (w *Writer) Close() ([]metastore.Chunk, chunkstore.Checksums, error) {
+	if w.closed {
+		return nil, chunkstore.Checksums{}, os.ErrClosed
+	}
+	w.closed = true
+
+	for len(w.buf) > 0 || len(w.chunks) == 0 {
+		err := w.flush()
+		if err != nil {
+			return nil, chunkstore.Checksums{}, err
+		}
+	}
+
+	w.chunker.mu.Lock()
+	w.chunker.stats.add(w.stats)
+	w.chunker.mu.Unlock()
+
+	checksums := chunkstore.Checksums{
+		MD5:    chunkstore.MD5Hash(w.md5Hasher.Sum(nil)),
+		CRC32C: w.crc32cHasher.Sum32(),
+	}
+	return w.chunks, checksums, nil
+}
+
+// Abort discards any buffered data. Chunks which were already written are left
+// for the garbage collector. It does nothing if the writer has been closed.
+func (w *Writer) Abort() error {
+	w.closed = true
+	w.buf = nil
+	return nil
+}
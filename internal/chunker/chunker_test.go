@@ -0,0 +1,153 @@
+package chunker_test
+
+import (
+	"bytes"
+	"crypto/md5"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+var testOptions = chunker.Options{
+	MinSize: 1 << 10,
+	AvgSize: 4 << 10,
+	MaxSize: 16 << 10,
+}
+
+func randomData(t *testing.T, seed int64, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.New(rand.NewSource(seed)).Read(data)
+	must.NoError(t, err)
+	return data
+}
+
+func write(t *testing.T, c *chunker.Chunker, data []byte) ([]metastore.Chunk, chunkstore.Checksums) {
+	w := c.NewWriter()
+
+	// Write in uneven pieces so chunks span writes.
+	for len(data) > 0 {
+		n := min(len(data), 3000)
+		_, err := w.Write(data[:n])
+		must.NoError(t, err)
+		data = data[n:]
+	}
+
+	chunks, checksums, err := w.Close()
+	must.NoError(t, err)
+	return chunks, checksums
+}
+
+func readChunks(t *testing.T, store chunkstore.Store, chunks []metastore.Chunk) []byte {
+	var buf bytes.Buffer
+	for _, chunk := range chunks {
+		r, err := store.NewReader(chunk.Hash)
+		must.NoError(t, err)
+		n, err := io.Copy(&buf, r)
+		must.NoError(t, err)
+		must.NoError(t, r.Close())
+		must.Eq(t, chunk.Size, n)
+	}
+	return buf.Bytes()
+}
+
+func TestWriter(t *testing.T) {
+	store := memory.New()
+	c := chunker.New(store, testOptions)
+
+	data := randomData(t, 1, 1<<20)
+	chunks, checksums := write(t, c, data)
+
+	must.True(t, bytes.Equal(data, readChunks(t, store, chunks)))
+	must.Eq(t, md5.Sum(data), checksums.MD5)
+	must.Eq(t, crc32c.Checksum(data), checksums.CRC32C)
+
+	for i, chunk := range chunks {
+		must.LessEq(t, int64(testOptions.MaxSize), chunk.Size)
+		if i < len(chunks)-1 {
+			must.GreaterEq(t, int64(testOptions.MinSize), chunk.Size)
+		}
+	}
+	// Chunks are cut by content rather than all at the maximum size.
+	must.Greater(t, (1<<20)/testOptions.MaxSize, len(chunks))
+
+	// How the data is split into writes doesn't affect the chunks.
+	w := c.NewWriter()
+	_, err := w.Write(data)
+	must.NoError(t, err)
+	whole, _, err := w.Close()
+	must.NoError(t, err)
+	must.Eq(t, chunks, whole)
+
+	chunks, _ = write(t, c, nil)
+	must.SliceLen(t, 1, chunks)
+	must.Eq(t, 0, chunks[0].Size)
+}
+
+func TestFixedSize(t *testing.T) {
+	store := memory.New()
+	c := chunker.New(store, chunker.FixedSize(1000))
+
+	data := randomData(t, 3, 10500)
+	chunks, _ := write(t, c, data)
+	must.True(t, bytes.Equal(data, readChunks(t, store, chunks)))
+
+	must.SliceLen(t, 11, chunks)
+	for _, chunk := range chunks[:10] {
+		must.Eq(t, 1000, chunk.Size)
+	}
+	must.Eq(t, 500, chunks[10].Size)
+}
+
+// countingStore counts how many chunks are written.
+type countingStore struct {
+	chunkstore.Store
+	writes int
+}
+
+func (s *countingStore) NewWriter() (chunkstore.ChunkWriter, error) {
+	s.writes++
+	return s.Store.NewWriter()
+}
+
+func TestDeduplication(t *testing.T) {
+	store := &countingStore{Store: memory.New()}
+	c := chunker.New(store, testOptions)
+
+	data := randomData(t, 2, 1<<20)
+	original, _ := write(t, c, data)
+	must.Eq(t, chunker.Stats{Chunks: int64(len(original)), Bytes: 1 << 20}, c.Stats())
+
+	// Inserting a byte only changes the chunks around it.
+	edited := append(bytes.Clone(data[:len(data)/2]), 'x')
+	edited = append(edited, data[len(data)/2:]...)
+	chunks, _ := write(t, c, edited)
+	must.True(t, bytes.Equal(edited, readChunks(t, store, chunks)))
+
+	stats := c.Stats()
+	must.Eq(t, int64(len(original)+len(chunks)), stats.Chunks)
+	must.Greater(t, int64(len(chunks)-3), stats.DuplicateChunks)
+	must.Greater(t, 1.9, stats.DedupRatio())
+
+	// Duplicate chunks aren't written again, but still have checksums.
+	must.Eq(t, stats.Chunks-stats.DuplicateChunks, int64(store.writes))
+	var offset int64
+	for _, chunk := range chunks {
+		must.Eq(t, crc32c.Checksum(edited[offset:offset+chunk.Size]), chunk.CRC32C)
+		offset += chunk.Size
+	}
+}
+
+func TestValidateOptions(t *testing.T) {
+	must.NoError(t, chunker.DefaultOptions.Validate())
+
+	options := chunker.Options{MinSize: 10, AvgSize: 5, MaxSize: 20}
+	must.Error(t, options.Validate())
+}
@@ -0,0 +1,63 @@
+package chunker
+
+import "math/bits"
+
+// gear maps each byte to a random value for the rolling gear hash. It must
+// never change, otherwise chunk boundaries shift and previously stored data
+// no longer deduplicates against new writes.
+var gear [256]uint64
+
+func init() {
+	// splitmix64 with a fixed seed.
+	state := uint64(0x6763732d656d7531)
+	for i := range gear {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// masks returns the masks used before and after reaching the average chunk
+// size. Following FastCDC's normalized chunking, a cut point is harder to hit
+// before the average size and easier after it, which narrows the spread of
+// chunk sizes. The masks select the high bits of the hash, which depend on the
+// last 64 bytes rather than only the last few.
+func masks(avgSize int) (small, large uint64) {
+	b := bits.Len(uint(avgSize)) - 1
+	small = ^uint64(0) << (64 - min(b+2, 64))
+	large = ^uint64(0) << (64 - max(b-2, 1))
+	return small, large
+}
+
+// cut returns the length of the first chunk in data. The length is always
+// between minSize and maxSize, unless data is shorter than minSize.
+func (o *Options) cut(data []byte) int {
+	n := len(data)
+	if n <= o.MinSize {
+		return n
+	}
+	if n > o.MaxSize {
+		n = o.MaxSize
+	}
+
+	normal := min(o.AvgSize, n)
+	small, large := masks(o.AvgSize)
+
+	var hash uint64
+	i := o.MinSize
+	for ; i < normal; i++ {
+		hash = hash<<1 + gear[data[i]]
+		if hash&small == 0 {
+			return i
+		}
+	}
+	for ; i < n; i++ {
+		hash = hash<<1 + gear[data[i]]
+		if hash&large == 0 {
+			return i
+		}
+	}
+	return n
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"io"
+	"time"
 )
 
 type ChunkHash = [sha256.Size]byte
@@ -13,9 +14,33 @@ type Store interface {
 	NewWriter() (ChunkWriter, error)
 	NewReader(ChunkHash) (io.ReadSeekCloser, error)
 	Delete(ChunkHash) error
+	// DeleteIfOlder deletes a chunk unless it has been written since cutoff,
+	// reporting whether it was deleted. The check and the delete are atomic
+	// with respect to writers of the same chunk.
+	DeleteIfOlder(hash ChunkHash, cutoff time.Time) (bool, error)
+	// Touch marks a chunk as written now without rewriting it, so
+	// DeleteIfOlder with an earlier cutoff leaves it alone. fs.ErrNotExist is
+	// returned if the chunk isn't in the store.
+	Touch(hash ChunkHash) error
+	// Walk calls fn for every chunk in the store along with the time it was
+	// last written.
+	Walk(fn func(hash ChunkHash, modTime time.Time) error) error
+	// RemovePartial removes data left behind by writers which were neither
+	// closed nor aborted, e.g. due to a crash, and which haven't been written
+	// to for at least olderThan. It returns how many writers were cleaned up.
+	RemovePartial(olderThan time.Duration) (int, error)
+}
+
+// Checksums are computed over a chunk's contents as it is written.
+type Checksums struct {
+	MD5    MD5Hash
+	CRC32C uint32
 }
 
 type ChunkWriter interface {
 	io.Writer
-	Close() (ChunkHash, MD5Hash, error)
+	Close() (ChunkHash, Checksums, error)
+	// Abort discards everything written so far. It does nothing if the
+	// writer has already been closed, so it may be deferred.
+	Abort() error
 }
@@ -1,14 +1,18 @@
 package chunkstore_test
 
 import (
+	"crypto/md5"
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/shoenig/test/must"
 
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore/file"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
 )
 
 func newFileStore(t *testing.T) chunkstore.Store {
@@ -24,12 +28,19 @@ func newFileStore(t *testing.T) chunkstore.Store {
 	return store
 }
 
+func newMemoryStore(t *testing.T) chunkstore.Store {
+	return memory.New()
+}
+
 var testCases = []struct {
 	name  string
 	store func(t *testing.T) chunkstore.Store
 }{{
 	name:  "file",
 	store: newFileStore,
+}, {
+	name:  "memory",
+	store: newMemoryStore,
 }}
 
 func TestWriteReadDeleteChunk(t *testing.T) {
@@ -46,8 +57,10 @@ func TestWriteReadDeleteChunk(t *testing.T) {
 			_, err = w.Write(contents)
 			must.NoError(t, err)
 
-			chunkHash, _, err := w.Close()
+			chunkHash, checksums, err := w.Close()
 			must.NoError(t, err)
+			must.Eq(t, md5.Sum(contents), checksums.MD5)
+			must.Eq(t, crc32c.Checksum(contents), checksums.CRC32C)
 
 			r, err := store.NewReader(chunkHash)
 			must.NoError(t, err)
@@ -77,3 +90,215 @@ func TestWriteReadDeleteChunk(t *testing.T) {
 		})
 	}
 }
+
+func TestWalkChunks(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			start := time.Now().Add(-time.Second)
+
+			var written []chunkstore.ChunkHash
+			for _, contents := range []string{"foo", "bar"} {
+				w, err := store.NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = io.WriteString(w, contents)
+				must.NoError(t, err)
+
+				chunkHash, _, err := w.Close()
+				must.NoError(t, err)
+				written = append(written, chunkHash)
+			}
+
+			var walked []chunkstore.ChunkHash
+			err := store.Walk(func(hash chunkstore.ChunkHash, modTime time.Time) error {
+				must.True(t, modTime.After(start))
+				walked = append(walked, hash)
+				return nil
+			})
+			must.NoError(t, err)
+			must.SliceContainsAll(t, written, walked)
+		})
+	}
+}
+
+func TestWalkChunksChangedDuringWalk(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			write := func(contents string) chunkstore.ChunkHash {
+				w, err := store.NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = io.WriteString(w, contents)
+				must.NoError(t, err)
+
+				chunkHash, _, err := w.Close()
+				must.NoError(t, err)
+				return chunkHash
+			}
+
+			chunks := map[chunkstore.ChunkHash]string{}
+			for _, contents := range []string{"foo", "bar", "baz"} {
+				chunks[write(contents)] = contents
+			}
+
+			written := map[chunkstore.ChunkHash]time.Time{}
+			err := store.Walk(func(hash chunkstore.ChunkHash, modTime time.Time) error {
+				written[hash] = modTime
+				return nil
+			})
+			must.NoError(t, err)
+
+			// The first chunk walked rewrites one of the others and deletes
+			// the last, which must then be reported with its new time or not
+			// at all.
+			var rewritten, removed chunkstore.ChunkHash
+			walked := map[chunkstore.ChunkHash]time.Time{}
+			err = store.Walk(func(hash chunkstore.ChunkHash, modTime time.Time) error {
+				if len(walked) == 0 {
+					for other := range chunks {
+						switch {
+						case other == hash:
+						case rewritten == chunkstore.ChunkHash{}:
+							rewritten = other
+							// File modification times are coarser than
+							// the clock.
+							time.Sleep(20 * time.Millisecond)
+							write(chunks[other])
+						default:
+							removed = other
+							must.NoError(t, store.Delete(other))
+						}
+					}
+				}
+				walked[hash] = modTime
+				return nil
+			})
+			must.NoError(t, err)
+
+			must.MapNotContainsKey(t, walked, removed)
+			must.MapContainsKey(t, walked, rewritten)
+			must.True(t, walked[rewritten].After(written[rewritten]))
+		})
+	}
+}
+
+func TestDeleteIfOlder(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			w, err := store.NewWriter()
+			must.NoError(t, err)
+			defer w.Close()
+
+			_, err = io.WriteString(w, "hello world")
+			must.NoError(t, err)
+
+			chunkHash, _, err := w.Close()
+			must.NoError(t, err)
+
+			// The chunk was written after the cutoff.
+			deleted, err := store.DeleteIfOlder(chunkHash, time.Now().Add(-time.Hour))
+			must.NoError(t, err)
+			must.False(t, deleted)
+
+			r, err := store.NewReader(chunkHash)
+			must.NoError(t, err)
+			r.Close()
+
+			deleted, err = store.DeleteIfOlder(chunkHash, time.Now().Add(time.Second))
+			must.NoError(t, err)
+			must.True(t, deleted)
+
+			_, err = store.NewReader(chunkHash)
+			must.ErrorIs(t, err, os.ErrNotExist)
+
+			_, err = store.DeleteIfOlder(chunkHash, time.Now())
+			must.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
+func TestTouch(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			w, err := store.NewWriter()
+			must.NoError(t, err)
+			defer w.Close()
+
+			_, err = io.WriteString(w, "hello world")
+			must.NoError(t, err)
+
+			chunkHash, _, err := w.Close()
+			must.NoError(t, err)
+
+			time.Sleep(10 * time.Millisecond)
+			cutoff := time.Now()
+
+			err = store.Touch(chunkHash)
+			must.NoError(t, err)
+
+			// The chunk was touched after the cutoff.
+			deleted, err := store.DeleteIfOlder(chunkHash, cutoff)
+			must.NoError(t, err)
+			must.False(t, deleted)
+
+			err = store.Delete(chunkHash)
+			must.NoError(t, err)
+
+			err = store.Touch(chunkHash)
+			must.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
+func TestAbortRemovePartial(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			aborted, err := store.NewWriter()
+			must.NoError(t, err)
+
+			_, err = io.WriteString(aborted, "aborted")
+			must.NoError(t, err)
+
+			err = aborted.Abort()
+			must.NoError(t, err)
+
+			_, _, err = aborted.Close()
+			must.Error(t, err)
+
+			abandoned, err := store.NewWriter()
+			must.NoError(t, err)
+			defer abandoned.Abort()
+
+			_, err = io.WriteString(abandoned, "abandoned")
+			must.NoError(t, err)
+
+			removed, err := store.RemovePartial(time.Hour)
+			must.NoError(t, err)
+			must.Eq(t, 0, removed)
+
+			removed, err = store.RemovePartial(0)
+			must.NoError(t, err)
+			must.Eq(t, 1, removed)
+
+			var walked int
+			err = store.Walk(func(chunkstore.ChunkHash, time.Time) error {
+				walked++
+				return nil
+			})
+			must.NoError(t, err)
+			must.Eq(t, 0, walked)
+		})
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"encoding/base32"
+	"errors"
 	"fmt"
 	"hash"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
 )
 
 var base32Encoder = base32.HexEncoding.WithPadding(base32.NoPadding)
@@ -23,27 +29,45 @@ func chunkPath(storeDir string, chunkHash chunkstore.ChunkHash) string {
 
 type store struct {
 	dir string
+
+	// mu is held while moving written chunks into place or touching them, so
+	// DeleteIfOlder can't remove a chunk which was just rewritten.
+	mu sync.Mutex
 }
 
 var _ chunkstore.Store = (*store)(nil)
 
 type chunkWriter struct {
-	storeDir     string
+	store        *store
 	file         *os.File
 	md5Hasher    hash.Hash
+	crc32cHasher hash.Hash32
 	sha256Hasher hash.Hash
 	closed       atomic.Bool
 }
 
 var _ chunkstore.ChunkWriter = (*chunkWriter)(nil)
 
+// New opens the chunk store in dir. Partially written chunks left behind by a
+// previous process are removed.
 func New(dir string) (chunkstore.Store, error) {
 	err := os.MkdirAll(dir, 0755)
 	if err != nil {
 		return nil, fmt.Errorf("make chunk dir: %w", err)
 	}
 
-	return &store{dir: dir}, nil
+	s := &store{dir: dir}
+
+	_, err = s.RemovePartial(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *store) uploadsDir() string {
+	return filepath.Join(s.dir, "uploads")
 }
 
 // NewReader implements chunkstore.Store.
@@ -56,9 +80,114 @@ func (s *store) Delete(hash chunkstore.ChunkHash) error {
 	return os.Remove(chunkPath(s.dir, hash))
 }
 
+// DeleteIfOlder implements chunkstore.Store.
+func (s *store) DeleteIfOlder(hash chunkstore.ChunkHash, cutoff time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := chunkPath(s.dir, hash)
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if info.ModTime().After(cutoff) {
+		return false, nil
+	}
+
+	err = os.Remove(path)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Touch implements chunkstore.Store.
+func (s *store) Touch(hash chunkstore.ChunkHash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	return os.Chtimes(chunkPath(s.dir, hash), now, now)
+}
+
+// Walk implements chunkstore.Store.
+func (s *store) Walk(fn func(hash chunkstore.ChunkHash, modTime time.Time) error) error {
+	err := filepath.WalkDir(filepath.Join(s.dir, "chunks"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		// Anything which isn't named like a chunk is left alone.
+		var hash chunkstore.ChunkHash
+		if len(d.Name()) != base32Encoder.EncodedLen(len(hash)) {
+			return nil
+		}
+		_, err = base32Encoder.Decode(hash[:], []byte(d.Name()))
+		if err != nil {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return fn(hash, info.ModTime())
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// RemovePartial implements chunkstore.Store.
+func (s *store) RemovePartial(olderThan time.Duration) (int, error) {
+	entries, err := os.ReadDir(s.uploadsDir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read uploads dir: %w", err)
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+
+	var removed int
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "partial-") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return removed, err
+		}
+		if info.ModTime().After(cutoff) {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(s.uploadsDir(), entry.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return removed, fmt.Errorf("remove partial chunk: %w", err)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
 // NewWriter implements chunkstore.Store.
 func (s *store) NewWriter() (chunkstore.ChunkWriter, error) {
-	uploadsDir := filepath.Join(s.dir, "uploads")
+	uploadsDir := s.uploadsDir()
 	err := os.MkdirAll(uploadsDir, 0755)
 	if err != nil {
 		return nil, fmt.Errorf("create uploads dir: %w", err)
@@ -71,9 +200,10 @@ func (s *store) NewWriter() (chunkstore.ChunkWriter, error) {
 
 	// TODO: Consider bufio?
 	return &chunkWriter{
-		storeDir:     s.dir,
+		store:        s,
 		file:         file,
 		md5Hasher:    md5.New(),
+		crc32cHasher: crc32c.New(),
 		sha256Hasher: sha256.New(),
 	}, nil
 }
@@ -86,42 +216,64 @@ func (w *chunkWriter) Write(p []byte) (int, error) {
 	}
 
 	w.md5Hasher.Write(p[:n])
+	w.crc32cHasher.Write(p[:n])
 	w.sha256Hasher.Write(p[:n])
 
 	return n, err
 }
 
+// Abort implements chunkstore.ChunkWriter.
+func (w *chunkWriter) Abort() error {
+	if w.closed.Swap(true) {
+		return nil
+	}
+
+	w.file.Close()
+
+	err := os.Remove(w.file.Name())
+	if err != nil {
+		return fmt.Errorf("remove partial chunk: %w", err)
+	}
+
+	return nil
+}
+
 // Close implements chunkstore.ChunkWriter.
-func (w *chunkWriter) Close() (chunkstore.ChunkHash, chunkstore.MD5Hash, error) {
+func (w *chunkWriter) Close() (chunkstore.ChunkHash, chunkstore.Checksums, error) {
 	if w.closed.Swap(true) {
-		return chunkstore.ChunkHash{}, chunkstore.MD5Hash{}, os.ErrClosed
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, os.ErrClosed
 	}
 
 	defer os.Remove(w.file.Name())
 
 	err := w.file.Sync()
 	if err != nil {
-		return chunkstore.ChunkHash{}, chunkstore.MD5Hash{}, fmt.Errorf("sync file: %w", err)
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, fmt.Errorf("sync file: %w", err)
 	}
 
 	err = w.file.Close()
 	if err != nil {
-		return chunkstore.ChunkHash{}, chunkstore.MD5Hash{}, fmt.Errorf("close file: %w", err)
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, fmt.Errorf("close file: %w", err)
 	}
 
-	md5Hash := chunkstore.MD5Hash(w.md5Hasher.Sum(nil))
+	checksums := chunkstore.Checksums{
+		MD5:    chunkstore.MD5Hash(w.md5Hasher.Sum(nil)),
+		CRC32C: w.crc32cHasher.Sum32(),
+	}
 	chunkHash := chunkstore.ChunkHash(w.sha256Hasher.Sum(nil))
 
-	dest := chunkPath(w.storeDir, chunkHash)
+	dest := chunkPath(w.store.dir, chunkHash)
 	err = os.MkdirAll(filepath.Dir(dest), 0755)
 	if err != nil {
-		return chunkstore.ChunkHash{}, chunkstore.MD5Hash{}, fmt.Errorf("make chunk dir: %w", err)
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, fmt.Errorf("make chunk dir: %w", err)
 	}
 
+	w.store.mu.Lock()
 	err = os.Rename(w.file.Name(), dest)
+	w.store.mu.Unlock()
 	if err != nil {
-		return chunkstore.ChunkHash{}, chunkstore.MD5Hash{}, fmt.Errorf("rename chunk: %w", err)
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, fmt.Errorf("rename chunk: %w", err)
 	}
 
-	return chunkHash, md5Hash, nil
+	return chunkHash, checksums, nil
 }
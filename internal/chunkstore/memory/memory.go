@@ -0,0 +1,259 @@
+package memory
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+)
+
+type chunk struct {
+	data    []byte
+	modTime time.Time
+}
+
+type store struct {
+	mu      sync.Mutex
+	chunks  map[chunkstore.ChunkHash]chunk
+	writers map[*chunkWriter]struct{}
+}
+
+var _ chunkstore.Store = (*store)(nil)
+
+type chunkWriter struct {
+	store        *store
+	buf          bytes.Buffer
+	md5Hasher    hash.Hash
+	crc32cHasher hash.Hash32
+	sha256Hasher hash.Hash
+	// modTime, closed and removed are guarded by the store's mutex.
+	modTime time.Time
+	closed  bool
+	removed bool
+}
+
+var _ chunkstore.ChunkWriter = (*chunkWriter)(nil)
+
+// New returns an empty chunk store which keeps chunks in memory.
+func New() chunkstore.Store {
+	return &store{
+		chunks:  make(map[chunkstore.ChunkHash]chunk),
+		writers: make(map[*chunkWriter]struct{}),
+	}
+}
+
+type chunkReader struct {
+	*bytes.Reader
+}
+
+// Close implements io.Closer.
+func (chunkReader) Close() error {
+	return nil
+}
+
+// NewReader implements chunkstore.Store.
+func (s *store) NewReader(hash chunkstore.ChunkHash) (io.ReadSeekCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chunks[hash]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+
+	// Chunks are never modified once written, so readers can share the data.
+	return chunkReader{bytes.NewReader(c.data)}, nil
+}
+
+// Delete implements chunkstore.Store.
+func (s *store) Delete(hash chunkstore.ChunkHash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.chunks[hash]; !ok {
+		return fs.ErrNotExist
+	}
+	delete(s.chunks, hash)
+
+	return nil
+}
+
+// DeleteIfOlder implements chunkstore.Store.
+func (s *store) DeleteIfOlder(hash chunkstore.ChunkHash, cutoff time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chunks[hash]
+	if !ok {
+		return false, fs.ErrNotExist
+	}
+	if c.modTime.After(cutoff) {
+		return false, nil
+	}
+	delete(s.chunks, hash)
+
+	return true, nil
+}
+
+// Touch implements chunkstore.Store.
+func (s *store) Touch(hash chunkstore.ChunkHash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.chunks[hash]
+	if !ok {
+		return fs.ErrNotExist
+	}
+	c.modTime = time.Now()
+	s.chunks[hash] = c
+
+	return nil
+}
+
+// Walk implements chunkstore.Store.
+func (s *store) Walk(fn func(hash chunkstore.ChunkHash, modTime time.Time) error) error {
+	// fn may call back into the store, so walk over a snapshot of the hashes.
+	// Each chunk's modification time is looked up when it's reached, like the
+	// file store does, so chunks rewritten or deleted during the walk aren't
+	// reported with stale times.
+	s.mu.Lock()
+	hashes := make([]chunkstore.ChunkHash, 0, len(s.chunks))
+	for hash := range s.chunks {
+		hashes = append(hashes, hash)
+	}
+	s.mu.Unlock()
+
+	for _, hash := range hashes {
+		s.mu.Lock()
+		c, ok := s.chunks[hash]
+		s.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		err := fn(hash, c.modTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemovePartial implements chunkstore.Store.
+func (s *store) RemovePartial(olderThan time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cutoff := time.Now().Add(-olderThan)
+
+	var removed int
+	for w := range s.writers {
+		if w.modTime.After(cutoff) {
+			continue
+		}
+
+		w.removed = true
+		w.buf = bytes.Buffer{}
+		delete(s.writers, w)
+		removed++
+	}
+
+	return removed, nil
+}
+
+// NewWriter implements chunkstore.Store.
+func (s *store) NewWriter() (chunkstore.ChunkWriter, error) {
+	w := &chunkWriter{
+		store:        s,
+		md5Hasher:    md5.New(),
+		crc32cHasher: crc32c.New(),
+		sha256Hasher: sha256.New(),
+		modTime:      time.Now(),
+	}
+
+	s.mu.Lock()
+	s.writers[w] = struct{}{}
+	s.mu.Unlock()
+
+	return w, nil
+}
+
+// Write implements chunkstore.ChunkWriter.
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+	w.modTime = time.Now()
+
+	// Data written after the writer was removed is discarded, Close will
+	// report the error.
+	if w.removed {
+		return len(p), nil
+	}
+
+	w.buf.Write(p)
+	w.md5Hasher.Write(p)
+	w.crc32cHasher.Write(p)
+	w.sha256Hasher.Write(p)
+
+	return len(p), nil
+}
+
+// Abort implements chunkstore.ChunkWriter.
+func (w *chunkWriter) Abort() error {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	delete(w.store.writers, w)
+
+	if w.removed {
+		return errors.New("partial chunk already removed")
+	}
+
+	return nil
+}
+
+// Close implements chunkstore.ChunkWriter.
+func (w *chunkWriter) Close() (chunkstore.ChunkHash, chunkstore.Checksums, error) {
+	w.store.mu.Lock()
+	defer w.store.mu.Unlock()
+
+	if w.closed {
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, os.ErrClosed
+	}
+	w.closed = true
+	delete(w.store.writers, w)
+
+	if w.removed {
+		return chunkstore.ChunkHash{}, chunkstore.Checksums{}, errors.New("partial chunk already removed")
+	}
+
+	checksums := chunkstore.Checksums{
+		MD5:    chunkstore.MD5Hash(w.md5Hasher.Sum(nil)),
+		CRC32C: w.crc32cHasher.Sum32(),
+	}
+	chunkHash := chunkstore.ChunkHash(w.sha256Hasher.Sum(nil))
+
+	// Rewriting an existing chunk refreshes its modification time, just like
+	// the file store replacing the chunk's file.
+	w.store.chunks[chunkHash] = chunk{data: w.buf.Bytes(), modTime: time.Now()}
+
+	return chunkHash, checksums, nil
+}
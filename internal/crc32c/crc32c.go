@@ -0,0 +1,83 @@
+// Package crc32c provides the CRC32C (Castagnoli) checksum used by GCS along
+// with a way to combine the checksums of concatenated data.
+package crc32c
+
+import (
+	"hash"
+	"hash/crc32"
+)
+
+// Table is the CRC32C lookup table.
+var Table = crc32.MakeTable(crc32.Castagnoli)
+
+// New returns a new hash computing the CRC32C checksum.
+func New() hash.Hash32 {
+	return crc32.New(Table)
+}
+
+// Checksum returns the CRC32C checksum of data.
+func Checksum(data []byte) uint32 {
+	return crc32.Checksum(data, Table)
+}
+
+// Combine returns the checksum of the concatenation of two pieces of data
+// given the checksum of each and the length of the second, without needing
+// the data itself. This is zlib's crc32_combine with the Castagnoli
+// polynomial.
+func Combine(crc1, crc2 uint32, len2 int64) uint32 {
+	if len2 <= 0 {
+		return crc1
+	}
+
+	// odd is the operator which applies a single zero bit to a checksum.
+	var even, odd [32]uint32
+	odd[0] = crc32.Castagnoli
+	row := uint32(1)
+	for n := 1; n < 32; n++ {
+		odd[n] = row
+		row <<= 1
+	}
+
+	// Square up to the operator for a single zero byte.
+	matrixSquare(&even, &odd)
+	matrixSquare(&odd, &even)
+
+	// Apply len2 zero bytes to crc1, squaring the operator each step.
+	for {
+		matrixSquare(&even, &odd)
+		if len2&1 != 0 {
+			crc1 = matrixTimes(&even, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+
+		matrixSquare(&odd, &even)
+		if len2&1 != 0 {
+			crc1 = matrixTimes(&odd, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+	}
+
+	return crc1 ^ crc2
+}
+
+func matrixTimes(matrix *[32]uint32, vec uint32) uint32 {
+	var sum uint32
+	for i := 0; vec != 0; i, vec = i+1, vec>>1 {
+		if vec&1 != 0 {
+			sum ^= matrix[i]
+		}
+	}
+	return sum
+}
+
+func matrixSquare(square, matrix *[32]uint32) {
+	for n := range matrix {
+		square[n] = matrixTimes(matrix, matrix[n])
+	}
+}
@@ -0,0 +1,29 @@
+package crc32c_test
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+)
+
+func TestCombine(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b string
+	}{
+		{name: "both empty"},
+		{name: "empty second", a: "hello"},
+		{name: "empty first", b: "world"},
+		{name: "short", a: "hello ", b: "world"},
+		{name: "long", a: string(make([]byte, 1000)), b: "the quick brown fox jumps over the lazy dog"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			combined := crc32c.Combine(crc32c.Checksum([]byte(tc.a)), crc32c.Checksum([]byte(tc.b)), int64(len(tc.b)))
+			must.Eq(t, crc32c.Checksum([]byte(tc.a+tc.b)), combined)
+		})
+	}
+}
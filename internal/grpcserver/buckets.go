@@ -0,0 +1,182 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+func toBucket(metadata *metastore.BucketMetadata) *storagepb.Bucket {
+	project := metadata.Project
+	if project == "" {
+		project = "0"
+	}
+
+	return &storagepb.Bucket{
+		Name:           bucketResource(metadata.Name),
+		BucketId:       metadata.Name,
+		Etag:           fmt.Sprint(metadata.Metageneration),
+		Project:        "projects/" + project,
+		Metageneration: metadata.Metageneration,
+		Location:       "US",
+		LocationType:   "multi-region",
+		StorageClass:   "STANDARD",
+		CreateTime:     timestamppb.New(metadata.CreatedAt),
+		UpdateTime:     timestamppb.New(metadata.UpdatedAt),
+		Versioning:     &storagepb.Bucket_Versioning{Enabled: metadata.Versioning},
+	}
+}
+
+// checkMetageneration checks the metageneration preconditions of bucket
+// requests.
+func checkMetageneration(metadata *metastore.BucketMetadata, match, notMatch *int64) error {
+	if (match != nil && *match != metadata.Metageneration) || (notMatch != nil && *notMatch == metadata.Metageneration) {
+		return status.Error(codes.FailedPrecondition, "At least one of the pre-conditions you specified did not hold.")
+	}
+	return nil
+}
+
+// GetBucket implements storagepb.StorageServer.
+func (s *Server) GetBucket(ctx context.Context, req *storagepb.GetBucketRequest) (*storagepb.Bucket, error) {
+	bucket, err := s.bucket(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = checkMetageneration(metadata, req.IfMetagenerationMatch, req.IfMetagenerationNotMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBucket(metadata), nil
+}
+
+// CreateBucket implements storagepb.StorageServer.
+func (s *Server) CreateBucket(ctx context.Context, req *storagepb.CreateBucketRequest) (*storagepb.Bucket, error) {
+	if req.GetBucketId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Bucket name is required.")
+	}
+
+	project := projectID(req.GetBucket().GetProject())
+	if project == "" {
+		project = projectID(req.GetParent())
+	}
+
+	bucket, err := s.store.CreateBucket(req.GetBucketId(), metastore.NewBucketOptions{
+		Project:    project,
+		Versioning: req.GetBucket().GetVersioning().GetEnabled(),
+	})
+	if errors.Is(err, metastore.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBucket(metadata), nil
+}
+
+// ListBuckets implements storagepb.StorageServer.
+func (s *Server) ListBuckets(ctx context.Context, req *storagepb.ListBucketsRequest) (*storagepb.ListBucketsResponse, error) {
+	cursor, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.store.ListBuckets(metastore.ListBucketsOptions{
+		Project:    projectID(req.GetParent()),
+		Prefix:     req.GetPrefix(),
+		Cursor:     cursor,
+		MaxResults: pageSize(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &storagepb.ListBucketsResponse{
+		NextPageToken: encodePageToken(list.NextCursor),
+	}
+	for _, metadata := range list.Buckets {
+		res.Buckets = append(res.Buckets, toBucket(metadata))
+	}
+
+	return res, nil
+}
+
+// UpdateBucket implements storagepb.StorageServer. Only versioning can be
+// changed.
+func (s *Server) UpdateBucket(ctx context.Context, req *storagepb.UpdateBucketRequest) (*storagepb.Bucket, error) {
+	bucket, err := s.bucket(req.GetBucket().GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = checkMetageneration(metadata, req.IfMetagenerationMatch, req.IfMetagenerationNotMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	var options metastore.UpdateBucketOptions
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "versioning", "versioning.enabled", "*":
+			enabled := req.GetBucket().GetVersioning().GetEnabled()
+			options.Versioning = &enabled
+		}
+	}
+
+	metadata, err = bucket.Update(options)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toBucket(metadata), nil
+}
+
+// DeleteBucket implements storagepb.StorageServer.
+func (s *Server) DeleteBucket(ctx context.Context, req *storagepb.DeleteBucketRequest) (*emptypb.Empty, error) {
+	bucket, err := s.bucket(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = checkMetageneration(metadata, req.IfMetagenerationMatch, req.IfMetagenerationNotMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.DeleteBucket(bucket.Name())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,262 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// maxReadChunkSize is the most data sent in a single ReadObjectResponse,
+// matching GCS.
+const maxReadChunkSize = 2 << 20
+
+// defaultContentType is reported for objects created without a content type.
+const defaultContentType = "application/octet-stream"
+
+func toChecksums(metadata *metastore.Object) *storagepb.ObjectChecksums {
+	crc := metadata.CRC32C
+	checksums := &storagepb.ObjectChecksums{Crc32C: &crc}
+	if metadata.MD5Sum != (chunkstore.MD5Hash{}) {
+		md5Sum := metadata.MD5Sum
+		checksums.Md5Hash = md5Sum[:]
+	}
+	return checksums
+}
+
+func toObject(bucket string, metadata *metastore.Object) *storagepb.Object {
+	object := &storagepb.Object{
+		Name:               metadata.Name,
+		Bucket:             bucketResource(bucket),
+		Etag:               objectETag(metadata),
+		Generation:         metadata.Generation,
+		Metageneration:     metadata.Metageneration,
+		StorageClass:       "STANDARD",
+		Size:               metadata.Size,
+		ContentType:        metadata.Attrs.ContentType,
+		ContentEncoding:    metadata.Attrs.ContentEncoding,
+		ContentDisposition: metadata.Attrs.ContentDisposition,
+		ContentLanguage:    metadata.Attrs.ContentLanguage,
+		CacheControl:       metadata.Attrs.CacheControl,
+		Metadata:           metadata.Attrs.Metadata,
+		ComponentCount:     int32(metadata.ComponentCount),
+		Checksums:          toChecksums(metadata),
+		CreateTime:         timestamppb.New(metadata.CreatedAt),
+		UpdateTime:         timestamppb.New(metadata.UpdatedAt),
+	}
+	if object.ContentType == "" {
+		object.ContentType = defaultContentType
+	}
+	if !metadata.DeletedAt.IsZero() {
+		object.DeleteTime = timestamppb.New(metadata.DeletedAt)
+	}
+	if !metadata.Attrs.CustomTime.IsZero() {
+		object.CustomTime = timestamppb.New(metadata.Attrs.CustomTime)
+	}
+	return object
+}
+
+// fromObject returns the writable attributes of an object resource.
+func fromObject(object *storagepb.Object) metastore.ObjectAttrs {
+	attrs := metastore.ObjectAttrs{
+		ContentType:        object.GetContentType(),
+		ContentEncoding:    object.GetContentEncoding(),
+		ContentDisposition: object.GetContentDisposition(),
+		ContentLanguage:    object.GetContentLanguage(),
+		CacheControl:       object.GetCacheControl(),
+		Metadata:           object.GetMetadata(),
+	}
+	if object.GetCustomTime() != nil {
+		attrs.CustomTime = object.GetCustomTime().AsTime()
+	}
+	return attrs
+}
+
+// objectRequest is implemented by requests which address a single object.
+type objectRequest interface {
+	GetBucket() string
+	GetObject() string
+	GetGeneration() int64
+}
+
+// object returns a handle to the object named by req, subject to conditions.
+func (s *Server) object(req objectRequest, conditions metastore.Conditions) (*objectstore.Bucket, *objectstore.Object, error) {
+	bucket, err := s.bucket(req.GetBucket())
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.GetObject() == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "Object name is required.")
+	}
+
+	object := bucket.Object(req.GetObject()).If(conditions).Generation(req.GetGeneration())
+	return bucket, object, nil
+}
+
+// GetObject implements storagepb.StorageServer.
+func (s *Server) GetObject(ctx context.Context, req *storagepb.GetObjectRequest) (*storagepb.Object, error) {
+	bucket, object, err := s.object(req, metastore.Conditions{
+		IfGenerationMatch:        req.IfGenerationMatch,
+		IfGenerationNotMatch:     req.IfGenerationNotMatch,
+		IfMetagenerationMatch:    req.IfMetagenerationMatch,
+		IfMetagenerationNotMatch: req.IfMetagenerationNotMatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := object.Metadata()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toObject(bucket.Name(), metadata), nil
+}
+
+// DeleteObject implements storagepb.StorageServer.
+func (s *Server) DeleteObject(ctx context.Context, req *storagepb.DeleteObjectRequest) (*emptypb.Empty, error) {
+	_, object, err := s.object(req, metastore.Conditions{
+		IfGenerationMatch:        req.IfGenerationMatch,
+		IfGenerationNotMatch:     req.IfGenerationNotMatch,
+		IfMetagenerationMatch:    req.IfMetagenerationMatch,
+		IfMetagenerationNotMatch: req.IfMetagenerationNotMatch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = object.Delete()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListObjects implements storagepb.StorageServer.
+func (s *Server) ListObjects(ctx context.Context, req *storagepb.ListObjectsRequest) (*storagepb.ListObjectsResponse, error) {
+	bucket, err := s.bucket(req.GetParent())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := bucket.ListObjects(metastore.ListObjectsOptions{
+		Prefix:                   req.GetPrefix(),
+		Delimiter:                req.GetDelimiter(),
+		IncludeTrailingDelimiter: req.GetIncludeTrailingDelimiter(),
+		StartOffset:              req.GetLexicographicStart(),
+		EndOffset:                req.GetLexicographicEnd(),
+		Cursor:                   cursor,
+		MaxResults:               pageSize(req.GetPageSize()),
+		Versions:                 req.GetVersions(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := &storagepb.ListObjectsResponse{
+		Prefixes:      list.Prefixes,
+		NextPageToken: encodePageToken(list.NextCursor),
+	}
+	for _, metadata := range list.Objects {
+		res.Objects = append(res.Objects, toObject(bucket.Name(), metadata))
+	}
+
+	return res, nil
+}
+
+// ReadObject implements storagepb.StorageServer. The first response carries
+// the object's metadata and checksums, and the data follows in pieces of at
+// most maxReadChunkSize.
+func (s *Server) ReadObject(req *storagepb.ReadObjectRequest, stream storagepb.Storage_ReadObjectServer) error {
+	bucket, object, err := s.object(req, metastore.Conditions{
+		IfGenerationMatch:        req.IfGenerationMatch,
+		IfGenerationNotMatch:     req.IfGenerationNotMatch,
+		IfMetagenerationMatch:    req.IfMetagenerationMatch,
+		IfMetagenerationNotMatch: req.IfMetagenerationNotMatch,
+	})
+	if err != nil {
+		return err
+	}
+
+	if req.GetReadLimit() < 0 {
+		return status.Error(codes.InvalidArgument, "Read limit must not be negative.")
+	}
+
+	reader, err := object.NewReader()
+	if err != nil {
+		return toStatus(err)
+	}
+	defer reader.Close()
+
+	metadata := reader.Metadata()
+
+	// Negative offsets are relative to the end of the object.
+	offset := req.GetReadOffset()
+	if offset < 0 {
+		offset = max(metadata.Size+offset, 0)
+	}
+	if offset > metadata.Size {
+		return status.Errorf(codes.OutOfRange, "Read offset %d is past the end of the object.", offset)
+	}
+
+	end := metadata.Size
+	if req.GetReadLimit() > 0 {
+		end = min(offset+req.GetReadLimit(), metadata.Size)
+	}
+
+	_, err = reader.Seek(offset, io.SeekStart)
+	if err != nil {
+		return toStatus(err)
+	}
+	reader.SetReadLimit(end)
+
+	res := &storagepb.ReadObjectResponse{
+		Metadata:        toObject(bucket.Name(), metadata),
+		ObjectChecksums: toChecksums(metadata),
+	}
+	if req.GetReadOffset() != 0 || req.GetReadLimit() != 0 {
+		res.ContentRange = &storagepb.ContentRange{
+			Start:          offset,
+			End:            end,
+			CompleteLength: metadata.Size,
+		}
+	}
+
+	buf := make([]byte, min(end-offset, maxReadChunkSize))
+	for remaining := end - offset; ; {
+		data := buf[:min(remaining, int64(len(buf)))]
+		_, err := io.ReadFull(reader, data)
+		if err != nil {
+			return toStatus(err)
+		}
+
+		crc := crc32c.Checksum(data)
+		res.ChecksummedData = &storagepb.ChecksummedData{Content: data, Crc32C: &crc}
+
+		err = stream.Send(res)
+		if err != nil {
+			return err
+		}
+
+		remaining -= int64(len(data))
+		if remaining == 0 {
+			return nil
+		}
+		res = &storagepb.ReadObjectResponse{}
+	}
+}
@@ -0,0 +1,134 @@
+// Package grpcserver serves the Cloud Storage gRPC API, google.storage.v2, on
+// top of an objectstore.Store.
+package grpcserver
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// Server implements the storage service. Buckets and objects are shared with
+// the JSON and XML APIs served from the same store.
+type Server struct {
+	storagepb.UnimplementedStorageServer
+
+	store *objectstore.Store
+}
+
+var _ storagepb.StorageServer = (*Server)(nil)
+
+// New returns a gRPC server serving the storage service from store.
+func New(store *objectstore.Store) *grpc.Server {
+	srv := grpc.NewServer()
+	storagepb.RegisterStorageServer(srv, &Server{store: store})
+	return srv
+}
+
+// toStatus converts errors from the store into gRPC status errors.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var preconditionErr *metastore.PreconditionError
+	var checksumErr *objectstore.ChecksumError
+	switch {
+	case errors.As(err, &preconditionErr):
+		return status.Error(codes.FailedPrecondition, "At least one of the pre-conditions you specified did not hold.")
+	case errors.As(err, &checksumErr):
+		return status.Errorf(codes.InvalidArgument, "Provided %s %x doesn't match calculated %x.", checksumErr.Checksum, checksumErr.Provided, checksumErr.Calculated)
+	case errors.Is(err, metastore.ErrNotExist):
+		return status.Error(codes.NotFound, "Not Found")
+	case errors.Is(err, metastore.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "Already Exists")
+	case errors.Is(err, metastore.ErrNotEmpty):
+		return status.Error(codes.FailedPrecondition, "The bucket you tried to delete is not empty.")
+	case errors.Is(err, metastore.ErrConflict):
+		return status.Error(codes.Aborted, "Concurrent write to the same upload.")
+	default:
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+const bucketPrefix = "projects/_/buckets/"
+
+// bucketName returns the name of a bucket from its resource name, which looks
+// like "projects/_/buckets/my-bucket".
+func bucketName(resource string) (string, error) {
+	name, ok := strings.CutPrefix(resource, bucketPrefix)
+	if !ok || name == "" {
+		return "", status.Errorf(codes.InvalidArgument, "Invalid bucket name %q.", resource)
+	}
+	return name, nil
+}
+
+func bucketResource(name string) string {
+	return bucketPrefix + name
+}
+
+// projectID returns the project from a resource name like "projects/my-project".
+// The "_" wildcard means any project.
+func projectID(resource string) string {
+	project := strings.TrimPrefix(resource, "projects/")
+	if project == "_" {
+		return ""
+	}
+	return project
+}
+
+func (s *Server) bucket(resource string) (*objectstore.Bucket, error) {
+	name, err := bucketName(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := s.store.Bucket(name)
+	if errors.Is(err, metastore.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "Bucket %s not found.", name)
+	}
+	return bucket, err
+}
+
+// encodePageToken turns a metastore cursor into an opaque page token.
+func encodePageToken(cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodePageToken(token string) (string, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, "Invalid page token.")
+	}
+	return string(cursor), nil
+}
+
+// pageSize returns the number of results to list, defaulting to and capping at
+// 1000 like GCS does.
+func pageSize(size int32) int {
+	const limit = 1000
+	if size <= 0 || size > limit {
+		return limit
+	}
+	return int(size)
+}
+
+func objectETag(metadata *metastore.Object) string {
+	return base64.StdEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d/%d", metadata.Generation, metadata.Metageneration)),
+	)
+}
@@ -0,0 +1,517 @@
+package grpcserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/shoenig/test/must"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore/file"
+	chunkmemory "github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore/bolt"
+	metamemory "github.com/cbrewster/gcs-emulator/internal/metastore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+func newFileStore(t *testing.T) chunkstore.Store {
+	dir, err := os.MkdirTemp("", "chunkstore-test-*")
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	store, err := file.New(dir)
+	must.NoError(t, err)
+
+	return store
+}
+
+func newBoltStore(t *testing.T) metastore.Store {
+	dir, err := os.MkdirTemp("", "metastore-test-*")
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	store, err := bolt.New(filepath.Join(dir, "db.bolt"))
+	must.NoError(t, err)
+
+	return store
+}
+
+func newChunkMemoryStore(t *testing.T) chunkstore.Store {
+	return chunkmemory.New()
+}
+
+func newMetaMemoryStore(t *testing.T) metastore.Store {
+	return metamemory.New()
+}
+
+var testCases = []struct {
+	name       string
+	metaStore  func(t *testing.T) metastore.Store
+	chunkStore func(t *testing.T) chunkstore.Store
+}{{
+	name:       "bolt+file",
+	metaStore:  newBoltStore,
+	chunkStore: newFileStore,
+}, {
+	name:       "memory+memory",
+	metaStore:  newMetaMemoryStore,
+	chunkStore: newChunkMemoryStore,
+}}
+
+// serve starts a server for store, returning its address.
+func serve(t *testing.T, store *objectstore.Store) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	must.NoError(t, err)
+
+	srv := grpcserver.New(store)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func newServer(t *testing.T, metaStore metastore.Store, chunkStore chunkstore.Store) (*grpc.ClientConn, *objectstore.Store) {
+	store := objectstore.New(metaStore, chunkStore)
+
+	conn, err := grpc.NewClient(serve(t, store), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	return conn, store
+}
+
+func mustCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	must.Eq(t, code, status.Code(err))
+}
+
+func checksummed(data string) *storagepb.ChecksummedData {
+	crc := crc32c.Checksum([]byte(data))
+	return &storagepb.ChecksummedData{Content: []byte(data), Crc32C: &crc}
+}
+
+func readObject(t *testing.T, client storagepb.StorageClient, req *storagepb.ReadObjectRequest) (*storagepb.ReadObjectResponse, string) {
+	t.Helper()
+
+	stream, err := client.ReadObject(context.Background(), req)
+	must.NoError(t, err)
+
+	first, err := stream.Recv()
+	must.NoError(t, err)
+
+	data := string(first.GetChecksummedData().GetContent())
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return first, data
+		}
+		must.NoError(t, err)
+		data += string(res.GetChecksummedData().GetContent())
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			client := storagepb.NewStorageClient(conn)
+			ctx := context.Background()
+
+			bucket, err := client.CreateBucket(ctx, &storagepb.CreateBucketRequest{
+				Parent:   "projects/_",
+				BucketId: "my-bucket",
+				Bucket:   &storagepb.Bucket{Project: "projects/test"},
+			})
+			must.NoError(t, err)
+			must.Eq(t, "projects/_/buckets/my-bucket", bucket.Name)
+			must.Eq(t, "projects/test", bucket.Project)
+
+			_, err = client.CreateBucket(ctx, &storagepb.CreateBucketRequest{Parent: "projects/_", BucketId: "my-bucket"})
+			mustCode(t, codes.AlreadyExists, err)
+
+			list, err := client.ListBuckets(ctx, &storagepb.ListBucketsRequest{Parent: "projects/test"})
+			must.NoError(t, err)
+			must.Len(t, 1, list.Buckets)
+
+			bucket, err = client.UpdateBucket(ctx, &storagepb.UpdateBucketRequest{
+				Bucket:     &storagepb.Bucket{Name: "projects/_/buckets/my-bucket", Versioning: &storagepb.Bucket_Versioning{Enabled: true}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"versioning"}},
+			})
+			must.NoError(t, err)
+			must.True(t, bucket.Versioning.Enabled)
+			must.Eq(t, 2, bucket.Metageneration)
+
+			objects, err := store.Bucket("my-bucket")
+			must.NoError(t, err)
+			w, err := objects.Object("foo").NewWriter()
+			must.NoError(t, err)
+			must.NoError(t, w.Close())
+
+			_, err = client.DeleteBucket(ctx, &storagepb.DeleteBucketRequest{Name: "projects/_/buckets/my-bucket"})
+			mustCode(t, codes.FailedPrecondition, err)
+
+			// Versioning is enabled, so the generation must be deleted.
+			object, err := client.GetObject(ctx, &storagepb.GetObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "foo"})
+			must.NoError(t, err)
+			_, err = client.DeleteObject(ctx, &storagepb.DeleteObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "foo", Generation: object.Generation})
+			must.NoError(t, err)
+
+			_, err = client.DeleteBucket(ctx, &storagepb.DeleteBucketRequest{Name: "projects/_/buckets/my-bucket"})
+			must.NoError(t, err)
+
+			_, err = client.GetBucket(ctx, &storagepb.GetBucketRequest{Name: "projects/_/buckets/my-bucket"})
+			mustCode(t, codes.NotFound, err)
+		})
+	}
+}
+
+func TestWriteReadObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			client := storagepb.NewStorageClient(conn)
+			ctx := context.Background()
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			stream, err := client.WriteObject(ctx)
+			must.NoError(t, err)
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				FirstMessage: &storagepb.WriteObjectRequest_WriteObjectSpec{
+					WriteObjectSpec: &storagepb.WriteObjectSpec{
+						Resource: &storagepb.Object{
+							Bucket:      "projects/_/buckets/my-bucket",
+							Name:        "hello",
+							ContentType: "text/plain",
+						},
+						IfGenerationMatch: proto.Int64(0),
+					},
+				},
+				Data: &storagepb.WriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("hello ")},
+			}))
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				WriteOffset: 6,
+				Data:        &storagepb.WriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("world")},
+				FinishWrite: true,
+			}))
+			res, err := stream.CloseAndRecv()
+			must.NoError(t, err)
+			must.Eq(t, "hello", res.GetResource().GetName())
+			must.Eq(t, 11, res.GetResource().GetSize())
+			must.Eq(t, "text/plain", res.GetResource().GetContentType())
+
+			// The object already exists, so the precondition fails.
+			stream, err = client.WriteObject(ctx)
+			must.NoError(t, err)
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				FirstMessage: &storagepb.WriteObjectRequest_WriteObjectSpec{
+					WriteObjectSpec: &storagepb.WriteObjectSpec{
+						Resource:          &storagepb.Object{Bucket: "projects/_/buckets/my-bucket", Name: "hello"},
+						IfGenerationMatch: proto.Int64(0),
+					},
+				},
+				FinishWrite: true,
+			}))
+			_, err = stream.CloseAndRecv()
+			mustCode(t, codes.FailedPrecondition, err)
+
+			// Data which doesn't match its checksum is rejected.
+			bad := checksummed("oops")
+			bad.Content = []byte("nope")
+			stream, err = client.WriteObject(ctx)
+			must.NoError(t, err)
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				FirstMessage: &storagepb.WriteObjectRequest_WriteObjectSpec{
+					WriteObjectSpec: &storagepb.WriteObjectSpec{
+						Resource: &storagepb.Object{Bucket: "projects/_/buckets/my-bucket", Name: "bad"},
+					},
+				},
+				Data:        &storagepb.WriteObjectRequest_ChecksummedData{ChecksummedData: bad},
+				FinishWrite: true,
+			}))
+			_, err = stream.CloseAndRecv()
+			mustCode(t, codes.InvalidArgument, err)
+
+			object, err := client.GetObject(ctx, &storagepb.GetObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello"})
+			must.NoError(t, err)
+			must.Eq(t, crc32c.Checksum([]byte("hello world")), object.GetChecksums().GetCrc32C())
+
+			first, data := readObject(t, client, &storagepb.ReadObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello"})
+			must.Eq(t, "hello world", data)
+			must.Eq(t, object.Generation, first.GetMetadata().GetGeneration())
+			must.Eq(t, object.GetChecksums().GetCrc32C(), first.GetObjectChecksums().GetCrc32C())
+			must.Nil(t, first.ContentRange)
+
+			first, data = readObject(t, client, &storagepb.ReadObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello", ReadOffset: 6, ReadLimit: 3})
+			must.Eq(t, "wor", data)
+			must.Eq(t, 6, first.GetContentRange().GetStart())
+			must.Eq(t, 9, first.GetContentRange().GetEnd())
+			must.Eq(t, 11, first.GetContentRange().GetCompleteLength())
+
+			_, data = readObject(t, client, &storagepb.ReadObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello", ReadOffset: -5})
+			must.Eq(t, "world", data)
+
+			read, err := client.ReadObject(ctx, &storagepb.ReadObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "missing"})
+			must.NoError(t, err)
+			_, err = read.Recv()
+			mustCode(t, codes.NotFound, err)
+
+			_, err = client.DeleteObject(ctx, &storagepb.DeleteObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello"})
+			must.NoError(t, err)
+
+			_, err = client.GetObject(ctx, &storagepb.GetObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "hello"})
+			mustCode(t, codes.NotFound, err)
+		})
+	}
+}
+
+func TestResumableWrite(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			client := storagepb.NewStorageClient(conn)
+			ctx := context.Background()
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			start, err := client.StartResumableWrite(ctx, &storagepb.StartResumableWriteRequest{
+				WriteObjectSpec: &storagepb.WriteObjectSpec{
+					Resource: &storagepb.Object{Bucket: "projects/_/buckets/my-bucket", Name: "big"},
+				},
+			})
+			must.NoError(t, err)
+			must.NotEq(t, "", start.UploadId)
+
+			// A stream which ends without finishing persists what was sent.
+			stream, err := client.WriteObject(ctx)
+			must.NoError(t, err)
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				FirstMessage: &storagepb.WriteObjectRequest_UploadId{UploadId: start.UploadId},
+				Data:         &storagepb.WriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("hello ")},
+			}))
+			res, err := stream.CloseAndRecv()
+			must.NoError(t, err)
+			must.Eq(t, 6, res.GetPersistedSize())
+
+			query, err := client.QueryWriteStatus(ctx, &storagepb.QueryWriteStatusRequest{UploadId: start.UploadId})
+			must.NoError(t, err)
+			must.Eq(t, 6, query.GetPersistedSize())
+
+			// Resending already persisted bytes should be skipped.
+			stream, err = client.WriteObject(ctx)
+			must.NoError(t, err)
+			must.NoError(t, stream.Send(&storagepb.WriteObjectRequest{
+				FirstMessage: &storagepb.WriteObjectRequest_UploadId{UploadId: start.UploadId},
+				WriteOffset:  4,
+				Data:         &storagepb.WriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("o world")},
+				FinishWrite:  true,
+			}))
+			res, err = stream.CloseAndRecv()
+			must.NoError(t, err)
+			must.Eq(t, 11, res.GetResource().GetSize())
+
+			_, data := readObject(t, client, &storagepb.ReadObjectRequest{Bucket: "projects/_/buckets/my-bucket", Object: "big"})
+			must.Eq(t, "hello world", data)
+
+			// Finished writes report the object they created.
+			query, err = client.QueryWriteStatus(ctx, &storagepb.QueryWriteStatusRequest{UploadId: start.UploadId})
+			must.NoError(t, err)
+			must.Eq(t, res.GetResource().GetGeneration(), query.GetResource().GetGeneration())
+
+			start, err = client.StartResumableWrite(ctx, &storagepb.StartResumableWriteRequest{
+				WriteObjectSpec: &storagepb.WriteObjectSpec{
+					Resource: &storagepb.Object{Bucket: "projects/_/buckets/my-bucket", Name: "cancelled"},
+				},
+			})
+			must.NoError(t, err)
+
+			_, err = client.CancelResumableWrite(ctx, &storagepb.CancelResumableWriteRequest{UploadId: start.UploadId})
+			must.NoError(t, err)
+
+			_, err = client.QueryWriteStatus(ctx, &storagepb.QueryWriteStatusRequest{UploadId: start.UploadId})
+			mustCode(t, codes.NotFound, err)
+		})
+	}
+}
+
+func TestBidiWriteObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			client := storagepb.NewStorageClient(conn)
+			ctx := context.Background()
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			stream, err := client.BidiWriteObject(ctx)
+			must.NoError(t, err)
+
+			must.NoError(t, stream.Send(&storagepb.BidiWriteObjectRequest{
+				FirstMessage: &storagepb.BidiWriteObjectRequest_WriteObjectSpec{
+					WriteObjectSpec: &storagepb.WriteObjectSpec{
+						Resource: &storagepb.Object{Bucket: "projects/_/buckets/my-bucket", Name: "bidi"},
+					},
+				},
+				Data:        &storagepb.BidiWriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("hello ")},
+				StateLookup: true,
+				Flush:       true,
+			}))
+
+			res, err := stream.Recv()
+			must.NoError(t, err)
+			must.Eq(t, 6, res.GetPersistedSize())
+
+			crc := crc32c.Checksum([]byte("hello world"))
+			must.NoError(t, stream.Send(&storagepb.BidiWriteObjectRequest{
+				WriteOffset:     6,
+				Data:            &storagepb.BidiWriteObjectRequest_ChecksummedData{ChecksummedData: checksummed("world")},
+				ObjectChecksums: &storagepb.ObjectChecksums{Crc32C: &crc},
+				FinishWrite:     true,
+			}))
+			must.NoError(t, stream.CloseSend())
+
+			res, err = stream.Recv()
+			must.NoError(t, err)
+			must.Eq(t, "bidi", res.GetResource().GetName())
+			must.Eq(t, 11, res.GetResource().GetSize())
+			_, err = stream.Recv()
+			must.Eq(t, io.EOF, err)
+
+			objects, err := store.Bucket("my-bucket")
+			must.NoError(t, err)
+			r, err := objects.Object("bidi").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(data))
+		})
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			client := storagepb.NewStorageClient(conn)
+			ctx := context.Background()
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+			for _, name := range []string{"a", "b/1", "b/2", "c"} {
+				w, err := bucket.Object(name).NewWriter()
+				must.NoError(t, err)
+				must.NoError(t, w.Close())
+			}
+
+			res, err := client.ListObjects(ctx, &storagepb.ListObjectsRequest{
+				Parent:    "projects/_/buckets/my-bucket",
+				Delimiter: "/",
+				PageSize:  2,
+			})
+			must.NoError(t, err)
+			must.Len(t, 1, res.Objects)
+			must.Eq(t, "a", res.Objects[0].Name)
+			must.Eq(t, []string{"b/"}, res.Prefixes)
+			must.NotEq(t, "", res.NextPageToken)
+
+			res, err = client.ListObjects(ctx, &storagepb.ListObjectsRequest{
+				Parent:    "projects/_/buckets/my-bucket",
+				Delimiter: "/",
+				PageSize:  2,
+				PageToken: res.NextPageToken,
+			})
+			must.NoError(t, err)
+			must.Len(t, 1, res.Objects)
+			must.Eq(t, "c", res.Objects[0].Name)
+			must.Eq(t, "", res.NextPageToken)
+
+			_, err = client.ListObjects(ctx, &storagepb.ListObjectsRequest{Parent: "projects/_/buckets/missing"})
+			mustCode(t, codes.NotFound, err)
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
+			t.Setenv("STORAGE_EMULATOR_HOST_GRPC", serve(t, store))
+			ctx := context.Background()
+
+			client, err := storage.NewGRPCClient(ctx)
+			must.NoError(t, err)
+			t.Cleanup(func() {
+				client.Close()
+			})
+
+			bucket := client.Bucket("my-bucket")
+			must.NoError(t, bucket.Create(ctx, "test", nil))
+
+			for name, data := range map[string]string{"hello": "hello world", "other": "other"} {
+				w := bucket.Object(name).NewWriter(ctx)
+				w.ContentType = "text/plain"
+				_, err = io.WriteString(w, data)
+				must.NoError(t, err)
+				must.NoError(t, w.Close())
+				must.Eq(t, int64(len(data)), w.Attrs().Size)
+			}
+
+			r, err := bucket.Object("hello").NewRangeReader(ctx, 6, 3)
+			must.NoError(t, err)
+			data, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.NoError(t, r.Close())
+			must.Eq(t, "wor", string(data))
+			must.Eq(t, "text/plain", r.Attrs.ContentType)
+			must.Eq(t, 11, r.Attrs.Size)
+
+			var names []string
+			it := bucket.Objects(ctx, nil)
+			for {
+				attrs, err := it.Next()
+				if errors.Is(err, iterator.Done) {
+					break
+				}
+				must.NoError(t, err)
+				names = append(names, attrs.Name)
+			}
+			must.Eq(t, []string{"hello", "other"}, names)
+
+			must.NoError(t, bucket.Object("hello").Delete(ctx))
+			must.NoError(t, bucket.Object("other").Delete(ctx))
+			_, err = bucket.Object("hello").Attrs(ctx)
+			must.ErrorIs(t, err, storage.ErrObjectNotExist)
+
+			must.NoError(t, bucket.Delete(ctx))
+			_, err = bucket.Attrs(ctx)
+			must.ErrorIs(t, err, storage.ErrBucketNotExist)
+		})
+	}
+}
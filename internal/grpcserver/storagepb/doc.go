@@ -0,0 +1,8 @@
+// Package storagepb contains the generated messages and service of the
+// storage v2 gRPC API, copied from the descriptor used by
+// cloud.google.com/go/storage. It uses the gcsemulator.storage.v2 proto
+// package rather than google.storage.v2, so it can be linked alongside the
+// client library.
+package storagepb
+
+//go:generate go run gen.go
@@ -0,0 +1,146 @@
+//go:build ignore
+
+// gen generates this package from the storage v2 descriptor registered by
+// cloud.google.com/go/storage, whose generated package is internal to that
+// module. The proto package is renamed so both can be linked into one binary,
+// and the gRPC service keeps its google.storage.v2.Storage name so the method
+// paths match the real API.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
+	_ "cloud.google.com/go/storage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	sourcePath    = "google/storage/v2/storage.proto"
+	sourcePackage = "google.storage.v2"
+
+	path       = "gcsemulator/storage/v2/storage.proto"
+	pkg        = "gcsemulator.storage.v2"
+	goPackage  = "github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	grpcPlugin = "google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.5.1"
+)
+
+func main() {
+	source, err := protoregistry.GlobalFiles.FindFileByPath(sourcePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Plugins need every file the generated one depends on, dependencies
+	// first.
+	var files []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		imports := file.Imports()
+		for i := range imports.Len() {
+			add(imports.Get(i).FileDescriptor)
+		}
+		files = append(files, protodesc.ToFileDescriptorProto(file))
+	}
+	add(source)
+
+	file := files[len(files)-1]
+	rename(file)
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{path},
+		Parameter:      proto.String("module=" + goPackage),
+		ProtoFile:      files,
+	}
+
+	run(req, "go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go")
+	run(req, "go", "run", grpcPlugin)
+}
+
+// rename moves file to the emulator's proto package.
+func rename(file *descriptorpb.FileDescriptorProto) {
+	file.Name = proto.String(path)
+	file.Package = proto.String(pkg)
+	file.Options.GoPackage = proto.String(goPackage + ";storagepb")
+
+	renameType := func(name *string) {
+		if name != nil && strings.HasPrefix(*name, "."+sourcePackage+".") {
+			*name = "." + pkg + strings.TrimPrefix(*name, "."+sourcePackage)
+		}
+	}
+
+	var renameMessage func(message *descriptorpb.DescriptorProto)
+	renameMessage = func(message *descriptorpb.DescriptorProto) {
+		for _, field := range message.Field {
+			renameType(field.TypeName)
+		}
+		for _, nested := range message.NestedType {
+			renameMessage(nested)
+		}
+	}
+	for _, message := range file.MessageType {
+		renameMessage(message)
+	}
+
+	for _, service := range file.Service {
+		for _, method := range service.Method {
+			renameType(method.InputType)
+			renameType(method.OutputType)
+		}
+	}
+}
+
+// run runs a protoc plugin and writes the files it generates.
+func run(req *pluginpb.CodeGeneratorRequest, command ...string) {
+	in, err := proto.Marshal(req)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var res pluginpb.CodeGeneratorResponse
+	err = proto.Unmarshal(out.Bytes(), &res)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if res.Error != nil {
+		log.Fatal(res.GetError())
+	}
+
+	for _, generated := range res.File {
+		content := generated.GetContent()
+		if strings.HasSuffix(generated.GetName(), "_grpc.pb.go") {
+			// The service is still served as google.storage.v2.Storage.
+			content = strings.ReplaceAll(content, `"`+pkg+".Storage", `"`+sourcePackage+".Storage")
+			content = strings.ReplaceAll(content, `"/`+pkg+".Storage/", `"/`+sourcePackage+".Storage/")
+		}
+		err := os.WriteFile(generated.GetName(), []byte(content), 0o644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(generated.GetName())
+	}
+}
@@ -0,0 +1,410 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver/storagepb"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// Writes from WriteObject and BidiWriteObject streams go through an
+// objectstore.Upload. Resumable writes use the upload created by
+// StartResumableWrite, while other writes use a temporary one which is
+// cancelled if the stream fails.
+
+// writeRequest holds the fields of WriteObjectRequest and
+// BidiWriteObjectRequest.
+type writeRequest struct {
+	uploadID  string
+	spec      *storagepb.WriteObjectSpec
+	offset    int64
+	data      *storagepb.ChecksummedData
+	checksums *storagepb.ObjectChecksums
+
+	// flush persists the data received so far, and stateLookup asks for the
+	// persisted size to be sent back. Only BidiWriteObject sets them.
+	flush       bool
+	stateLookup bool
+	finish      bool
+}
+
+// writeStatus is the persisted size of a write, or the object once it has
+// been finished.
+type writeStatus struct {
+	persistedSize int64
+	resource      *storagepb.Object
+}
+
+// writeStream adapts the WriteObject and BidiWriteObject streams.
+type writeStream interface {
+	recv() (*writeRequest, error)
+	// send sends an intermediate response, if the stream supports them.
+	send(status writeStatus) error
+	// close sends the final response.
+	close(status writeStatus) error
+}
+
+func expectedChecksums(checksums *storagepb.ObjectChecksums) (metastore.ExpectedChecksums, error) {
+	var expected metastore.ExpectedChecksums
+	if checksums != nil && checksums.Crc32C != nil {
+		crc := *checksums.Crc32C
+		expected.CRC32C = &crc
+	}
+	if len(checksums.GetMd5Hash()) > 0 {
+		if len(checksums.Md5Hash) != md5.Size {
+			return metastore.ExpectedChecksums{}, status.Error(codes.InvalidArgument, "Invalid MD5 hash.")
+		}
+		md5Sum := [md5.Size]byte(checksums.Md5Hash)
+		expected.MD5 = &md5Sum
+	}
+	return expected, nil
+}
+
+// newUpload starts an upload for the object described by spec.
+func (s *Server) newUpload(spec *storagepb.WriteObjectSpec, checksums *storagepb.ObjectChecksums) (*objectstore.Upload, error) {
+	resource := spec.GetResource()
+
+	bucket, err := s.bucket(resource.GetBucket())
+	if err != nil {
+		return nil, err
+	}
+	if resource.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Object name is required.")
+	}
+
+	expected, err := expectedChecksums(checksums)
+	if err != nil {
+		return nil, err
+	}
+
+	object := bucket.Object(resource.GetName()).If(metastore.Conditions{
+		IfGenerationMatch:        spec.IfGenerationMatch,
+		IfGenerationNotMatch:     spec.IfGenerationNotMatch,
+		IfMetagenerationMatch:    spec.IfMetagenerationMatch,
+		IfMetagenerationNotMatch: spec.IfMetagenerationNotMatch,
+	})
+
+	upload, err := object.NewUpload(fromObject(resource), expected)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return upload, nil
+}
+
+// objectWrite is an object being written from a stream of requests.
+type objectWrite struct {
+	upload    *objectstore.Upload
+	resumable bool
+
+	// Data is appended to the upload through a pipe, so chunk boundaries
+	// don't depend on how the data was split into messages.
+	pipe     *io.PipeWriter
+	appended chan error
+	// written is the size of the upload including data which hasn't been
+	// flushed yet.
+	written int64
+}
+
+// write appends the data in req, skipping any which was already written.
+func (w *objectWrite) write(req *writeRequest) error {
+	data := req.data.GetContent()
+	if req.data != nil && req.data.Crc32C != nil && crc32c.Checksum(data) != *req.data.Crc32C {
+		return status.Error(codes.InvalidArgument, "Provided CRC32C doesn't match calculated CRC32C of the data.")
+	}
+
+	if req.offset > w.written {
+		return status.Errorf(codes.OutOfRange, "Write offset %d is past the %d bytes written so far.", req.offset, w.written)
+	}
+	data = data[min(w.written-req.offset, int64(len(data))):]
+	if len(data) == 0 {
+		return nil
+	}
+
+	if w.pipe == nil {
+		pr, pw := io.Pipe()
+		w.pipe = pw
+		w.appended = make(chan error, 1)
+		go func() {
+			_, err := w.upload.Append(pr)
+			// Unblock writes if appending failed.
+			pr.CloseWithError(err)
+			w.appended <- err
+		}()
+	}
+
+	_, err := w.pipe.Write(data)
+	if err != nil {
+		return w.flush()
+	}
+	w.written += int64(len(data))
+
+	return nil
+}
+
+// flush waits for the data written so far to be persisted.
+func (w *objectWrite) flush() error {
+	if w.pipe == nil {
+		return nil
+	}
+
+	w.pipe.Close()
+	err := <-w.appended
+	w.pipe = nil
+	w.written = w.upload.Size()
+
+	if err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
+
+// abort stops the write after a failure. Resumable writes keep the data which
+// was received, so they can be resumed.
+func (w *objectWrite) abort() {
+	if w.resumable {
+		w.flush()
+		return
+	}
+
+	if w.pipe != nil {
+		w.pipe.CloseWithError(errors.New("write aborted"))
+		<-w.appended
+		w.pipe = nil
+	}
+	w.upload.Cancel()
+}
+
+func (w *objectWrite) finish(checksums *storagepb.ObjectChecksums) (*metastore.Object, error) {
+	err := w.flush()
+	if err != nil {
+		return nil, err
+	}
+
+	expected, err := expectedChecksums(checksums)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := w.upload.Finish(expected)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return metadata, nil
+}
+
+func (s *Server) startWrite(req *writeRequest) (*objectWrite, error) {
+	switch {
+	case req.uploadID != "":
+		upload, err := s.store.Upload(req.uploadID)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		return &objectWrite{upload: upload, resumable: true, written: upload.Size()}, nil
+	case req.spec != nil:
+		upload, err := s.newUpload(req.spec, req.checksums)
+		if err != nil {
+			return nil, err
+		}
+		return &objectWrite{upload: upload}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "The first message must set upload_id or write_object_spec.")
+	}
+}
+
+// write handles the requests of a WriteObject or BidiWriteObject stream.
+func (s *Server) write(stream writeStream) error {
+	var w *objectWrite
+	for {
+		req, err := stream.recv()
+		if errors.Is(err, io.EOF) {
+			if w == nil {
+				return status.Error(codes.InvalidArgument, "No write requests were sent.")
+			}
+			if !w.resumable {
+				w.abort()
+				return status.Error(codes.InvalidArgument, "The stream ended without finish_write.")
+			}
+
+			err = w.flush()
+			if err != nil {
+				return err
+			}
+			return stream.close(writeStatus{persistedSize: w.upload.Size()})
+		}
+		if err != nil {
+			if w != nil {
+				w.abort()
+			}
+			return err
+		}
+
+		if w == nil {
+			w, err = s.startWrite(req)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = w.write(req)
+		if err != nil {
+			w.abort()
+			return err
+		}
+
+		if req.finish {
+			metadata, err := w.finish(req.checksums)
+			if err != nil {
+				w.abort()
+				return err
+			}
+			return stream.close(writeStatus{resource: toObject(w.upload.Bucket(), metadata)})
+		}
+
+		if req.flush {
+			err = w.flush()
+			if err != nil {
+				w.abort()
+				return err
+			}
+		}
+		if req.stateLookup {
+			err = stream.send(writeStatus{persistedSize: w.upload.Size()})
+			if err != nil {
+				w.abort()
+				return err
+			}
+		}
+	}
+}
+
+type writeObjectStream struct {
+	storagepb.Storage_WriteObjectServer
+}
+
+func (s writeObjectStream) recv() (*writeRequest, error) {
+	req, err := s.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &writeRequest{
+		uploadID:  req.GetUploadId(),
+		spec:      req.GetWriteObjectSpec(),
+		offset:    req.GetWriteOffset(),
+		data:      req.GetChecksummedData(),
+		checksums: req.GetObjectChecksums(),
+		finish:    req.GetFinishWrite(),
+	}, nil
+}
+
+func (s writeObjectStream) send(writeStatus) error {
+	return nil
+}
+
+func (s writeObjectStream) close(status writeStatus) error {
+	res := &storagepb.WriteObjectResponse{}
+	if status.resource != nil {
+		res.WriteStatus = &storagepb.WriteObjectResponse_Resource{Resource: status.resource}
+	} else {
+		res.WriteStatus = &storagepb.WriteObjectResponse_PersistedSize{PersistedSize: status.persistedSize}
+	}
+	return s.SendAndClose(res)
+}
+
+// WriteObject implements storagepb.StorageServer.
+func (s *Server) WriteObject(stream storagepb.Storage_WriteObjectServer) error {
+	return s.write(writeObjectStream{stream})
+}
+
+type bidiWriteObjectStream struct {
+	storagepb.Storage_BidiWriteObjectServer
+}
+
+func (s bidiWriteObjectStream) recv() (*writeRequest, error) {
+	req, err := s.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &writeRequest{
+		uploadID:    req.GetUploadId(),
+		spec:        req.GetWriteObjectSpec(),
+		offset:      req.GetWriteOffset(),
+		data:        req.GetChecksummedData(),
+		checksums:   req.GetObjectChecksums(),
+		flush:       req.GetFlush(),
+		stateLookup: req.GetStateLookup(),
+		finish:      req.GetFinishWrite(),
+	}, nil
+}
+
+func (s bidiWriteObjectStream) send(status writeStatus) error {
+	res := &storagepb.BidiWriteObjectResponse{}
+	if status.resource != nil {
+		res.WriteStatus = &storagepb.BidiWriteObjectResponse_Resource{Resource: status.resource}
+	} else {
+		res.WriteStatus = &storagepb.BidiWriteObjectResponse_PersistedSize{PersistedSize: status.persistedSize}
+	}
+	return s.Send(res)
+}
+
+func (s bidiWriteObjectStream) close(status writeStatus) error {
+	return s.send(status)
+}
+
+// BidiWriteObject implements storagepb.StorageServer. It's like WriteObject
+// but allows clients to flush data and look up the persisted size
+// mid-stream.
+func (s *Server) BidiWriteObject(stream storagepb.Storage_BidiWriteObjectServer) error {
+	return s.write(bidiWriteObjectStream{stream})
+}
+
+// StartResumableWrite implements storagepb.StorageServer.
+func (s *Server) StartResumableWrite(ctx context.Context, req *storagepb.StartResumableWriteRequest) (*storagepb.StartResumableWriteResponse, error) {
+	upload, err := s.newUpload(req.GetWriteObjectSpec(), req.GetObjectChecksums())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StartResumableWriteResponse{UploadId: upload.ID()}, nil
+}
+
+// CancelResumableWrite implements storagepb.StorageServer.
+func (s *Server) CancelResumableWrite(ctx context.Context, req *storagepb.CancelResumableWriteRequest) (*storagepb.CancelResumableWriteResponse, error) {
+	upload, err := s.store.Upload(req.GetUploadId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	err = upload.Cancel()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &storagepb.CancelResumableWriteResponse{}, nil
+}
+
+// QueryWriteStatus implements storagepb.StorageServer.
+func (s *Server) QueryWriteStatus(ctx context.Context, req *storagepb.QueryWriteStatusRequest) (*storagepb.QueryWriteStatusResponse, error) {
+	upload, err := s.store.Upload(req.GetUploadId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if metadata := upload.Finished(); metadata != nil {
+		return &storagepb.QueryWriteStatusResponse{
+			WriteStatus: &storagepb.QueryWriteStatusResponse_Resource{Resource: toObject(upload.Bucket(), metadata)},
+		}, nil
+	}
+
+	return &storagepb.QueryWriteStatusResponse{
+		WriteStatus: &storagepb.QueryWriteStatusResponse_PersistedSize{PersistedSize: upload.Size()},
+	}, nil
+}
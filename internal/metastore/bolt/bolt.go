@@ -1,15 +1,21 @@
 package bolt
 
 import (
+	"cmp"
 	"crypto/md5"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.etcd.io/bbolt"
 
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
 	"github.com/cbrewster/gcs-emulator/internal/metastore"
 )
 
@@ -20,24 +26,148 @@ var (
 	objectsBucketName = []byte("buckets")
 	// bucketMetaKey contains metadata about the bucket configuration.
 	bucketMetaKey = []byte("metadata")
+	// uploadsBucketName is the root bolt bucket where in-progress resumable
+	// uploads are stored.
+	uploadsBucketName = []byte("uploads")
 )
 
 type bucketMetadata struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
 
-	Generation     int64      `json:"generation"`
-	Metageneration int64      `json:"metageneration"`
-	Versioning     versioning `json:"versioning,omitempty"`
+	Project          string           `json:"project,omitempty"`
+	Generation       int64            `json:"generation"`
+	Metageneration   int64            `json:"metageneration"`
+	Versioning       versioning       `json:"versioning,omitempty"`
+	SoftDeletePolicy softDeletePolicy `json:"soft_delete_policy,omitempty"`
+}
+
+type softDeletePolicy struct {
+	RetentionDuration time.Duration `json:"retention_duration,omitempty"`
 }
 
 type versioning struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+func (m *bucketMetadata) metadata(name string) *metastore.BucketMetadata {
+	return &metastore.BucketMetadata{
+		Name:    name,
+		Project: m.Project,
+
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+
+		Metageneration:      m.Metageneration,
+		Versioning:          m.Versioning.Enabled,
+		SoftDeleteRetention: m.SoftDeletePolicy.RetentionDuration,
+	}
+}
+
+func readBucketMetadata(b *bbolt.Bucket) (*bucketMetadata, error) {
+	metaBytes := b.Get(bucketMetaKey)
+	if metaBytes == nil {
+		return nil, errors.New("bucket missing metadata")
+	}
+
+	var metadata bucketMetadata
+	err := json.Unmarshal(metaBytes, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal bucket metadata: %w", err)
+	}
+
+	return &metadata, nil
+}
+
 type objectMetadata struct {
-	Current    *objectVersion  `json:"current,omitempty"`
-	NonCurrent []objectVersion `json:"non_current,omitempty"`
+	Current     *objectVersion  `json:"current,omitempty"`
+	NonCurrent  []objectVersion `json:"non_current,omitempty"`
+	SoftDeleted []objectVersion `json:"soft_deleted,omitempty"`
+}
+
+func decodeObjectMetadata(metaBytes []byte) (objectMetadata, error) {
+	var metadata objectMetadata
+	err := json.Unmarshal(metaBytes, &metadata)
+	if err != nil {
+		return objectMetadata{}, fmt.Errorf("unmarshal object metadata: %w", err)
+	}
+
+	// Soft-deleted versions are purged lazily once their retention expires.
+	now := time.Now()
+	metadata.SoftDeleted = slices.DeleteFunc(metadata.SoftDeleted, func(v objectVersion) bool {
+		return !v.HardDeleteAt.After(now)
+	})
+
+	return metadata, nil
+}
+
+// version returns the live version of the object if generation is zero, or
+// else the live or noncurrent version with that generation. It returns nil if
+// there is no such version.
+func (m *objectMetadata) version(generation int64) *objectVersion {
+	if m.Current != nil && (generation == 0 || m.Current.Generation == generation) {
+		return m.Current
+	}
+	if generation == 0 {
+		return nil
+	}
+
+	i := slices.IndexFunc(m.NonCurrent, func(v objectVersion) bool {
+		return v.Generation == generation
+	})
+	if i < 0 {
+		return nil
+	}
+	return &m.NonCurrent[i]
+}
+
+// empty reports whether no versions of the object are left.
+func (m *objectMetadata) empty() bool {
+	return m.Current == nil && len(m.NonCurrent) == 0 && len(m.SoftDeleted) == 0
+}
+
+// setCurrent makes version the live version of the object. The previous live
+// version becomes noncurrent on versioned buckets, otherwise it's discarded.
+func (m *objectMetadata) setCurrent(version *objectVersion, bucket *bucketMetadata) {
+	if m.Current != nil {
+		if bucket.Versioning.Enabled {
+			m.archive(*m.Current)
+		} else {
+			m.discard(*m.Current, bucket)
+		}
+	}
+	m.Current = version
+}
+
+// archive keeps version as a noncurrent version of the object.
+func (m *objectMetadata) archive(version objectVersion) {
+	version.DeletedAt = time.Now()
+	m.NonCurrent = append(m.NonCurrent, version)
+}
+
+// discard drops version from the object, keeping it around as soft-deleted if
+// the bucket has a soft delete policy.
+func (m *objectMetadata) discard(version objectVersion, bucket *bucketMetadata) {
+	retention := bucket.SoftDeletePolicy.RetentionDuration
+	if retention <= 0 {
+		return
+	}
+
+	now := time.Now()
+	if version.DeletedAt.IsZero() {
+		version.DeletedAt = now
+	}
+	version.SoftDeletedAt = now
+	version.HardDeleteAt = now.Add(retention)
+	m.SoftDeleted = append(m.SoftDeleted, version)
+}
+
+// check checks conditions against the live version of the object.
+func (m *objectMetadata) check(conditions metastore.Conditions) error {
+	if m.Current == nil {
+		return conditions.Check(0, 0)
+	}
+	return conditions.Check(m.Current.Generation, m.Current.Metageneration)
 }
 
 type objectVersion struct {
@@ -45,10 +175,136 @@ type objectVersion struct {
 	CreatedAt time.Time `json:"created_at"`
 	DeletedAt time.Time `json:"deleted_at"`
 
-	Chunks         []chunkstore.ChunkHash `json:"chunks"`
-	MD5            [md5.Size]byte         `json:"md5,omitempty"`
-	Generation     int64                  `json:"generation"`
-	Metageneration int64                  `json:"metageneration"`
+	SoftDeletedAt time.Time `json:"soft_deleted_at,omitempty"`
+	HardDeleteAt  time.Time `json:"hard_delete_at,omitempty"`
+
+	Chunks         []chunk        `json:"chunks"`
+	MD5            [md5.Size]byte `json:"md5,omitempty"`
+	CRC32C         uint32         `json:"crc32c"`
+	Size           int64          `json:"size"`
+	Generation     int64          `json:"generation"`
+	Metageneration int64          `json:"metageneration"`
+	ComponentCount int64          `json:"component_count,omitempty"`
+
+	Attrs objectAttrs `json:"attrs"`
+}
+
+type objectAttrs struct {
+	ContentType        string            `json:"content_type,omitempty"`
+	ContentEncoding    string            `json:"content_encoding,omitempty"`
+	ContentDisposition string            `json:"content_disposition,omitempty"`
+	ContentLanguage    string            `json:"content_language,omitempty"`
+	CacheControl       string            `json:"cache_control,omitempty"`
+	CustomTime         time.Time         `json:"custom_time,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+}
+
+func fromAttrs(attrs metastore.ObjectAttrs) objectAttrs {
+	return objectAttrs{
+		ContentType:        attrs.ContentType,
+		ContentEncoding:    attrs.ContentEncoding,
+		ContentDisposition: attrs.ContentDisposition,
+		ContentLanguage:    attrs.ContentLanguage,
+		CacheControl:       attrs.CacheControl,
+		CustomTime:         attrs.CustomTime,
+		Metadata:           maps.Clone(attrs.Metadata),
+	}
+}
+
+func (a *objectAttrs) attrs() metastore.ObjectAttrs {
+	return metastore.ObjectAttrs{
+		ContentType:        a.ContentType,
+		ContentEncoding:    a.ContentEncoding,
+		ContentDisposition: a.ContentDisposition,
+		ContentLanguage:    a.ContentLanguage,
+		CacheControl:       a.CacheControl,
+		CustomTime:         a.CustomTime,
+		Metadata:           maps.Clone(a.Metadata),
+	}
+}
+
+// update applies the non-nil fields of options to the attributes.
+func (a *objectAttrs) update(options metastore.UpdateObjectOptions) {
+	if options.ContentType != nil {
+		a.ContentType = *options.ContentType
+	}
+	if options.ContentEncoding != nil {
+		a.ContentEncoding = *options.ContentEncoding
+	}
+	if options.ContentDisposition != nil {
+		a.ContentDisposition = *options.ContentDisposition
+	}
+	if options.ContentLanguage != nil {
+		a.ContentLanguage = *options.ContentLanguage
+	}
+	if options.CacheControl != nil {
+		a.CacheControl = *options.CacheControl
+	}
+	if options.CustomTime != nil {
+		a.CustomTime = *options.CustomTime
+	}
+
+	if options.ReplaceMetadata {
+		a.Metadata = nil
+	}
+	for key, value := range options.Metadata {
+		if value == nil {
+			delete(a.Metadata, key)
+			continue
+		}
+		if a.Metadata == nil {
+			a.Metadata = make(map[string]string)
+		}
+		a.Metadata[key] = *value
+	}
+	if len(a.Metadata) == 0 {
+		a.Metadata = nil
+	}
+}
+
+type chunk struct {
+	Hash   chunkstore.ChunkHash `json:"hash"`
+	Size   int64                `json:"size"`
+	CRC32C uint32               `json:"crc32c"`
+}
+
+func fromChunks(chunks []metastore.Chunk) []chunk {
+	out := make([]chunk, len(chunks))
+	for i, c := range chunks {
+		out[i] = chunk{Hash: c.Hash, Size: c.Size, CRC32C: c.CRC32C}
+	}
+	return out
+}
+
+func toChunks(chunks []chunk) []metastore.Chunk {
+	out := make([]metastore.Chunk, len(chunks))
+	for i, c := range chunks {
+		out[i] = metastore.Chunk{Hash: c.Hash, Size: c.Size, CRC32C: c.CRC32C}
+	}
+	return out
+}
+
+func (v *objectVersion) object(name string) *metastore.Object {
+	return &metastore.Object{
+		Name: name,
+
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+		DeletedAt: v.DeletedAt,
+
+		SoftDeletedAt: v.SoftDeletedAt,
+		HardDeleteAt:  v.HardDeleteAt,
+
+		Chunks:         toChunks(v.Chunks),
+		MD5Sum:         v.MD5,
+		CRC32C:         v.CRC32C,
+		Size:           v.Size,
+		Generation:     v.Generation,
+		Metageneration: v.Metageneration,
+		ComponentCount: v.ComponentCount,
+
+		Attrs: v.Attrs.attrs(),
+	}
 }
 
 type store struct {
@@ -85,6 +341,11 @@ func New(path string) (metastore.Store, error) {
 		return nil, fmt.Errorf("create root bucket: %w", err)
 	}
 
+	_, err = tx.CreateBucketIfNotExists(uploadsBucketName)
+	if err != nil {
+		return nil, fmt.Errorf("create uploads bucket: %w", err)
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, fmt.Errorf("commit root bucket: %w", err)
@@ -124,11 +385,15 @@ func (s *store) CreateBucket(
 		UpdatedAt: time.Now(),
 		CreatedAt: time.Now(),
 
+		Project:        options.Project,
 		Generation:     newGeneration(),
 		Metageneration: 1,
 		Versioning: versioning{
 			Enabled: options.Versioning,
 		},
+		SoftDeletePolicy: softDeletePolicy{
+			RetentionDuration: options.SoftDeleteRetention,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("marshal bucket metadata: %w", err)
@@ -155,6 +420,23 @@ func (s *store) DeleteBucket(name string) error {
 	}
 	defer tx.Rollback()
 
+	b := tx.Bucket(rootBucketName).Bucket([]byte(name))
+	if b == nil {
+		return metastore.ErrNotExist
+	}
+
+	// Soft-deleted objects don't prevent a bucket from being deleted.
+	cursor := b.Bucket(objectsBucketName).Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		metadata, err := decodeObjectMetadata(v)
+		if err != nil {
+			return err
+		}
+		if metadata.Current != nil || len(metadata.NonCurrent) > 0 {
+			return metastore.ErrNotEmpty
+		}
+	}
+
 	err = tx.Bucket(rootBucketName).DeleteBucket([]byte(name))
 	if err != nil {
 		return fmt.Errorf("delete bucket: %w", err)
@@ -168,6 +450,101 @@ func (s *store) DeleteBucket(name string) error {
 	return nil
 }
 
+// ListBuckets implements metastore.Store.
+func (s *store) ListBuckets(options metastore.ListBucketsOptions) (*metastore.BucketList, error) {
+	tx, err := s.db.Begin(false)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	root := tx.Bucket(rootBucketName)
+	cursor := root.Cursor()
+
+	var list metastore.BucketList
+	for k, _ := cursor.Seek([]byte(max(options.Prefix, options.Cursor))); k != nil; k, _ = cursor.Next() {
+		name := string(k)
+		if !strings.HasPrefix(name, options.Prefix) {
+			break
+		}
+
+		metadata, err := readBucketMetadata(root.Bucket(k))
+		if err != nil {
+			return nil, err
+		}
+		if options.Project != "" && metadata.Project != options.Project {
+			continue
+		}
+
+		if options.MaxResults > 0 && len(list.Buckets) >= options.MaxResults {
+			list.NextCursor = name
+			break
+		}
+
+		list.Buckets = append(list.Buckets, metadata.metadata(name))
+	}
+
+	return &list, nil
+}
+
+// WalkChunkRefs implements metastore.Store.
+func (s *store) WalkChunkRefs(fn func(hash chunkstore.ChunkHash) error) error {
+	tx, err := s.db.Begin(false)
+	if err != nil {
+		return fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	root := tx.Bucket(rootBucketName)
+	err = root.ForEachBucket(func(name []byte) error {
+		return root.Bucket(name).Bucket(objectsBucketName).ForEach(func(_, v []byte) error {
+			// Expired soft-deleted versions are dropped while decoding, so
+			// their chunks are no longer referenced.
+			metadata, err := decodeObjectMetadata(v)
+			if err != nil {
+				return err
+			}
+
+			versions := slices.Concat(metadata.NonCurrent, metadata.SoftDeleted)
+			if metadata.Current != nil {
+				versions = append(versions, *metadata.Current)
+			}
+			for _, version := range versions {
+				for _, c := range version.Chunks {
+					err := fn(c.Hash)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Bucket(uploadsBucketName).ForEach(func(_, v []byte) error {
+		var u upload
+		err := json.Unmarshal(v, &u)
+		if err != nil {
+			return fmt.Errorf("unmarshal upload: %w", err)
+		}
+
+		chunks := u.Chunks
+		for _, p := range u.Parts {
+			chunks = append(chunks, p.Chunks...)
+		}
+		for _, c := range chunks {
+			err := fn(c.Hash)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // Bucket implements Store.
 func (s *store) Bucket(name string) (metastore.Bucket, error) {
 	tx, err := s.db.Begin(false)
@@ -189,18 +566,7 @@ func (b *bucket) objectsBucket(tx *bbolt.Tx) *bbolt.Bucket {
 }
 
 func (b *bucket) bucketMetadata(tx *bbolt.Tx) (*bucketMetadata, error) {
-	metaBytes := tx.Bucket(rootBucketName).Bucket([]byte(b.name)).Get(bucketMetaKey)
-	if metaBytes == nil {
-		return nil, errors.New("bucket missing metadata")
-	}
-
-	var metadata bucketMetadata
-	err := json.Unmarshal(metaBytes, &metadata)
-	if err != nil {
-		return nil, fmt.Errorf("unmarshal bucket metadata: %w", err)
-	}
-
-	return &metadata, nil
+	return readBucketMetadata(tx.Bucket(rootBucketName).Bucket([]byte(b.name)))
 }
 
 func (b *bucket) objectMetadata(tx *bbolt.Tx, name []byte) (objectMetadata, error) {
@@ -209,16 +575,20 @@ func (b *bucket) objectMetadata(tx *bbolt.Tx, name []byte) (objectMetadata, erro
 		return objectMetadata{}, nil
 	}
 
-	var metadata objectMetadata
-	err := json.Unmarshal(metaBytes, &metadata)
-	if err != nil {
-		return objectMetadata{}, fmt.Errorf("unmarshal object metadata: %w", err)
-	}
-
-	return metadata, nil
+	return decodeObjectMetadata(metaBytes)
 }
 
+// putObjectMetadata stores the object's metadata, removing it entirely once no
+// versions are left.
 func (b *bucket) putObjectMetadata(tx *bbolt.Tx, name []byte, metadata *objectMetadata) error {
+	if metadata.empty() {
+		err := b.objectsBucket(tx).Delete(name)
+		if err != nil {
+			return fmt.Errorf("delete object metadata: %w", err)
+		}
+		return nil
+	}
+
 	metaBytes, err := json.Marshal(metadata)
 	if err != nil {
 		return fmt.Errorf("marshal object metadata: %w", err)
@@ -245,11 +615,7 @@ func (b *bucket) Metadata() (*metastore.BucketMetadata, error) {
 		return nil, err
 	}
 
-	return &metastore.BucketMetadata{
-		CreatedAt:  metadata.CreatedAt,
-		UpdatedAt:  metadata.UpdatedAt,
-		Versioning: metadata.Versioning.Enabled,
-	}, nil
+	return metadata.metadata(string(b.name)), nil
 }
 
 // Object implements Bucket.
@@ -267,18 +633,70 @@ func (b *bucket) Object(name string) (*metastore.Object, error) {
 	if metadata.Current == nil {
 		return nil, metastore.ErrNotExist
 	}
-	version := metadata.Current
 
-	return &metastore.Object{
-		CreatedAt: version.CreatedAt,
-		UpdatedAt: version.UpdatedAt,
-		DeletedAt: version.DeletedAt,
+	return metadata.Current.object(name), nil
+}
 
-		Chunks:         version.Chunks,
-		MD5Sum:         version.MD5,
-		Generation:     version.Generation,
-		Metageneration: version.Metageneration,
-	}, nil
+// ObjectVersion implements Bucket.
+func (b *bucket) ObjectVersion(name string, generation int64) (*metastore.Object, error) {
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range metadata.listedVersions(metastore.ListObjectsOptions{Versions: true}) {
+		if version.Generation == generation {
+			return version.object(name), nil
+		}
+	}
+
+	return nil, metastore.ErrNotExist
+}
+
+// Update implements Bucket.
+func (b *bucket) Update(options metastore.UpdateBucketOptions) (*metastore.BucketMetadata, error) {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	metadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Versioning != nil {
+		metadata.Versioning.Enabled = *options.Versioning
+	}
+	if options.SoftDeleteRetention != nil {
+		metadata.SoftDeletePolicy.RetentionDuration = *options.SoftDeleteRetention
+	}
+	metadata.Metageneration++
+	metadata.UpdatedAt = time.Now()
+
+	metaBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bucket metadata: %w", err)
+	}
+
+	err = tx.Bucket(rootBucketName).Bucket(b.name).Put(bucketMetaKey, metaBytes)
+	if err != nil {
+		return nil, fmt.Errorf("write bucket meta: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit update bucket: %w", err)
+	}
+
+	return metadata.metadata(string(b.name)), nil
 }
 
 // PutObject implements Bucket.
@@ -297,45 +715,497 @@ func (b *bucket) PutObject(
 		return nil, err
 	}
 
-	oldMetadata, err := b.objectMetadata(tx, []byte(name))
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	err = metadata.check(options.Conditions)
 	if err != nil {
 		return nil, err
 	}
 
-	newMetadata := objectMetadata{
-		Current: &objectVersion{
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+	metadata.setCurrent(&objectVersion{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 
-			Chunks:         options.Chunks,
-			MD5:            options.MD5Sum,
-			Generation:     newGeneration(),
-			Metageneration: 1,
-		},
+		Chunks:         fromChunks(options.Chunks),
+		MD5:            options.MD5Sum,
+		CRC32C:         options.CRC32C,
+		Size:           options.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: options.ComponentCount,
+
+		Attrs: fromAttrs(options.Attrs),
+	}, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit put object: %w", err)
+	}
+
+	return metadata.Current.object(name), nil
+}
+
+// ComposeObject implements metastore.Bucket.
+func (b *bucket) ComposeObject(name string, options metastore.ComposeObjectOptions) (*metastore.Object, error) {
+	if len(options.Sources) == 0 || len(options.Sources) > metastore.MaxComposeSources {
+		return nil, fmt.Errorf("compose %d sources: must be between 1 and %d", len(options.Sources), metastore.MaxComposeSources)
+	}
+
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	composite := objectVersion{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Generation:     newGeneration(),
+		Metageneration: 1,
+
+		Attrs: fromAttrs(options.Attrs),
 	}
-	if oldMetadata.Current != nil && bucketMetadata.Versioning.Enabled {
-		newMetadata.NonCurrent = append(oldMetadata.NonCurrent, *oldMetadata.Current)
+
+	for _, source := range options.Sources {
+		sourceMetadata, err := b.objectMetadata(tx, []byte(source.Name))
+		if err != nil {
+			return nil, err
+		}
+
+		version := sourceMetadata.version(source.Generation)
+		if version == nil {
+			return nil, metastore.ErrNotExist
+		}
+
+		err = source.Conditions.Check(version.Generation, version.Metageneration)
+		if err != nil {
+			return nil, err
+		}
+
+		composite.Chunks = append(composite.Chunks, version.Chunks...)
+		composite.CRC32C = crc32c.Combine(composite.CRC32C, version.CRC32C, version.Size)
+		composite.Size += version.Size
+		composite.ComponentCount += max(version.ComponentCount, 1)
 	}
 
-	err = b.putObjectMetadata(tx, []byte(name), &newMetadata)
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&composite, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
 	if err != nil {
 		return nil, err
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return nil, fmt.Errorf("commit put object: %w", err)
+		return nil, fmt.Errorf("commit compose object: %w", err)
 	}
 
-	version := newMetadata.Current
-	return &metastore.Object{
-		CreatedAt: version.CreatedAt,
-		UpdatedAt: version.UpdatedAt,
-		DeletedAt: version.DeletedAt,
+	return metadata.Current.object(name), nil
+}
+
+// CopyObject implements metastore.Bucket.
+func (b *bucket) CopyObject(name string, options metastore.CopyObjectOptions) (*metastore.Object, error) {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Bucket(rootBucketName).Bucket([]byte(options.SourceBucket)) == nil {
+		return nil, metastore.ErrNotExist
+	}
+	source := &bucket{db: b.db, name: []byte(options.SourceBucket)}
+
+	sourceMetadata, err := source.objectMetadata(tx, []byte(options.SourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	version := sourceMetadata.version(options.SourceGeneration)
+	if version == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = options.SourceConditions.Check(version.Generation, version.Metageneration)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := version.Attrs
+	if options.Attrs != nil {
+		attrs = fromAttrs(*options.Attrs)
+	}
+
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&objectVersion{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
 
 		Chunks:         version.Chunks,
-		MD5Sum:         version.MD5,
-		Generation:     version.Generation,
-		Metageneration: version.Metageneration,
-	}, nil
+		MD5:            version.MD5,
+		CRC32C:         version.CRC32C,
+		Size:           version.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: version.ComponentCount,
+
+		Attrs: attrs,
+	}, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit copy object: %w", err)
+	}
+
+	return metadata.Current.object(name), nil
+}
+
+// DeleteObject implements Bucket.
+func (b *bucket) DeleteObject(name string, options metastore.DeleteObjectOptions) error {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return err
+	}
+
+	if options.Generation != 0 && (metadata.Current == nil || metadata.Current.Generation != options.Generation) {
+		// Deleting a specific noncurrent version removes it for good, unless
+		// it is retained by a soft delete policy.
+		i := slices.IndexFunc(metadata.NonCurrent, func(v objectVersion) bool {
+			return v.Generation == options.Generation
+		})
+		if i < 0 {
+			return metastore.ErrNotExist
+		}
+
+		version := metadata.NonCurrent[i]
+		err = options.Conditions.Check(version.Generation, version.Metageneration)
+		if err != nil {
+			return err
+		}
+
+		metadata.NonCurrent = slices.Delete(metadata.NonCurrent, i, i+1)
+		metadata.discard(version, bucketMetadata)
+	} else {
+		if metadata.Current == nil {
+			return metastore.ErrNotExist
+		}
+
+		err = metadata.check(options.Conditions)
+		if err != nil {
+			return err
+		}
+
+		// Deleting the live version by its generation discards it, otherwise
+		// it's kept as a noncurrent version on versioned buckets.
+		if bucketMetadata.Versioning.Enabled && options.Generation == 0 {
+			metadata.archive(*metadata.Current)
+		} else {
+			metadata.discard(*metadata.Current, bucketMetadata)
+		}
+		metadata.Current = nil
+	}
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("commit delete object: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateObject implements metastore.Bucket.
+func (b *bucket) UpdateObject(name string, options metastore.UpdateObjectOptions) (*metastore.Object, error) {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	version := metadata.version(options.Generation)
+	if version == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = options.Conditions.Check(version.Generation, version.Metageneration)
+	if err != nil {
+		return nil, err
+	}
+
+	version.Attrs.update(options)
+	version.Metageneration++
+	version.UpdatedAt = time.Now()
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit update object: %w", err)
+	}
+
+	return version.object(name), nil
+}
+
+// RestoreObject implements metastore.Bucket.
+func (b *bucket) RestoreObject(name string, options metastore.RestoreObjectOptions) (*metastore.Object, error) {
+	tx, err := b.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := b.objectMetadata(tx, []byte(name))
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(metadata.SoftDeleted, func(v objectVersion) bool {
+		return v.Generation == options.Generation
+	})
+	if i < 0 {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The restored object is a copy of the soft-deleted version with a new
+	// generation.
+	version := metadata.SoftDeleted[i]
+	metadata.SoftDeleted = slices.Delete(metadata.SoftDeleted, i, i+1)
+
+	version.Generation = newGeneration()
+	version.Metageneration = 1
+	version.CreatedAt = time.Now()
+	version.UpdatedAt = time.Now()
+	version.DeletedAt = time.Time{}
+	version.SoftDeletedAt = time.Time{}
+	version.HardDeleteAt = time.Time{}
+	metadata.setCurrent(&version, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(name), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit restore object: %w", err)
+	}
+
+	return metadata.Current.object(name), nil
+}
+
+// versionCursor encodes a listing position within the versions of an object.
+func versionCursor(name string, generation int64) string {
+	return name + "\x00" + strconv.FormatInt(generation, 10)
+}
+
+func parseCursor(cursor string) (string, int64) {
+	name, generation, ok := strings.Cut(cursor, "\x00")
+	if !ok {
+		return cursor, 0
+	}
+	gen, _ := strconv.ParseInt(generation, 10, 64)
+	return name, gen
+}
+
+// listedVersions returns the versions of the object to include in a listing,
+// ordered by generation.
+func (m *objectMetadata) listedVersions(options metastore.ListObjectsOptions) []objectVersion {
+	if options.SoftDeleted {
+		// Versions are soft deleted in any order, e.g. after restoring one.
+		versions := slices.Clone(m.SoftDeleted)
+		slices.SortFunc(versions, func(a, b objectVersion) int {
+			return cmp.Compare(a.Generation, b.Generation)
+		})
+		return versions
+	}
+
+	var versions []objectVersion
+	if options.Versions {
+		versions = append(versions, m.NonCurrent...)
+	}
+	if m.Current != nil {
+		versions = append(versions, *m.Current)
+	}
+	return versions
+}
+
+// ListObjects implements Bucket.
+func (b *bucket) ListObjects(options metastore.ListObjectsOptions) (*metastore.ObjectList, error) {
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	objects := b.objectsBucket(tx)
+	cursor := objects.Cursor()
+
+	cursorName, cursorGeneration := parseCursor(options.Cursor)
+	start := max(options.Prefix, options.StartOffset, cursorName)
+
+	var list metastore.ObjectList
+	var count int
+	// full reports whether n more results would exceed MaxResults. At least
+	// one result is always listed, so listings make progress.
+	full := func(n int) bool {
+		return options.MaxResults > 0 && count > 0 && count+n > options.MaxResults
+	}
+
+	k, v := cursor.Seek([]byte(start))
+	for k != nil {
+		name := string(k)
+		if !strings.HasPrefix(name, options.Prefix) {
+			break
+		}
+		if options.EndOffset != "" && name >= options.EndOffset {
+			break
+		}
+
+		metadata, err := decodeObjectMetadata(v)
+		if err != nil {
+			return nil, err
+		}
+		versions := metadata.listedVersions(options)
+		if len(versions) == 0 {
+			k, v = cursor.Next()
+			continue
+		}
+
+		if options.Delimiter != "" {
+			rest := name[len(options.Prefix):]
+			if i := strings.Index(rest, options.Delimiter); i >= 0 {
+				prefix := options.Prefix + rest[:i+len(options.Delimiter)]
+
+				var prefixObjects []*metastore.Object
+				if options.IncludeTrailingDelimiter {
+					prefixMetadata, err := b.objectMetadata(tx, []byte(prefix))
+					if err != nil {
+						return nil, err
+					}
+					for _, version := range prefixMetadata.listedVersions(options) {
+						prefixObjects = append(prefixObjects, version.object(prefix))
+					}
+				}
+
+				// The prefix and the objects named like it can't be split
+				// across pages, so they're counted together.
+				if full(1 + len(prefixObjects)) {
+					list.NextCursor = name
+					break
+				}
+				count += 1 + len(prefixObjects)
+
+				list.Prefixes = append(list.Prefixes, prefix)
+				list.Objects = append(list.Objects, prefixObjects...)
+
+				// Skip over everything rolled up into this prefix.
+				end := metastore.PrefixEnd(prefix)
+				if end == "" {
+					break
+				}
+				k, v = cursor.Seek([]byte(end))
+				continue
+			}
+		}
+
+		for _, version := range versions {
+			if name == cursorName && version.Generation < cursorGeneration {
+				continue
+			}
+
+			if full(1) {
+				list.NextCursor = name
+				if options.Versions || options.SoftDeleted {
+					list.NextCursor = versionCursor(name, version.Generation)
+				}
+				break
+			}
+			count++
+
+			list.Objects = append(list.Objects, version.object(name))
+		}
+		if list.NextCursor != "" {
+			break
+		}
+
+		k, v = cursor.Next()
+	}
+
+	return &list, nil
 }
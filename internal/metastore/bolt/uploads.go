@@ -0,0 +1,512 @@
+package bolt
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+type upload struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Bucket    string  `json:"bucket"`
+	Object    string  `json:"object"`
+	Chunks    []chunk `json:"chunks"`
+	Size      int64   `json:"size"`
+	HashState []byte  `json:"hash_state,omitempty"`
+
+	Multipart bool         `json:"multipart,omitempty"`
+	Parts     []uploadPart `json:"parts,omitempty"`
+
+	Finished *objectVersion `json:"finished,omitempty"`
+
+	Attrs      objectAttrs       `json:"attrs"`
+	Checksums  expectedChecksums `json:"checksums"`
+	Conditions conditions        `json:"conditions"`
+}
+
+type uploadPart struct {
+	Number    int       `json:"number"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Chunks []chunk        `json:"chunks"`
+	MD5    [md5.Size]byte `json:"md5"`
+	CRC32C uint32         `json:"crc32c"`
+	Size   int64          `json:"size"`
+}
+
+func (p *uploadPart) part() metastore.UploadPart {
+	return metastore.UploadPart{
+		Number:    p.Number,
+		UpdatedAt: p.UpdatedAt,
+
+		Chunks: toChunks(p.Chunks),
+		MD5Sum: p.MD5,
+		CRC32C: p.CRC32C,
+		Size:   p.Size,
+	}
+}
+
+type expectedChecksums struct {
+	MD5    *[md5.Size]byte `json:"md5,omitempty"`
+	CRC32C *uint32         `json:"crc32c,omitempty"`
+}
+
+type conditions struct {
+	IfGenerationMatch        *int64 `json:"if_generation_match,omitempty"`
+	IfGenerationNotMatch     *int64 `json:"if_generation_not_match,omitempty"`
+	IfMetagenerationMatch    *int64 `json:"if_metageneration_match,omitempty"`
+	IfMetagenerationNotMatch *int64 `json:"if_metageneration_not_match,omitempty"`
+}
+
+func (c *conditions) conditions() metastore.Conditions {
+	return metastore.Conditions{
+		IfGenerationMatch:        c.IfGenerationMatch,
+		IfGenerationNotMatch:     c.IfGenerationNotMatch,
+		IfMetagenerationMatch:    c.IfMetagenerationMatch,
+		IfMetagenerationNotMatch: c.IfMetagenerationNotMatch,
+	}
+}
+
+func (u *upload) upload(id string) *metastore.Upload {
+	var parts []metastore.UploadPart
+	for _, p := range u.Parts {
+		parts = append(parts, p.part())
+	}
+
+	var finished *metastore.Object
+	if u.Finished != nil {
+		finished = u.Finished.object(u.Object)
+	}
+
+	return &metastore.Upload{
+		ID:        id,
+		Bucket:    u.Bucket,
+		Object:    u.Object,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+
+		Chunks:    toChunks(u.Chunks),
+		Size:      u.Size,
+		HashState: u.HashState,
+
+		Multipart: u.Multipart,
+		Parts:     parts,
+
+		Finished: finished,
+
+		Attrs: u.Attrs.attrs(),
+		Checksums: metastore.ExpectedChecksums{
+			MD5:    u.Checksums.MD5,
+			CRC32C: u.Checksums.CRC32C,
+		},
+		Conditions: u.Conditions.conditions(),
+	}
+}
+
+func newUploadID() (string, error) {
+	var id [16]byte
+	_, err := rand.Read(id[:])
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
+}
+
+func getUpload(tx *bbolt.Tx, id string) (*upload, error) {
+	uploadBytes := tx.Bucket(uploadsBucketName).Get([]byte(id))
+	if uploadBytes == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	var u upload
+	err := json.Unmarshal(uploadBytes, &u)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal upload: %w", err)
+	}
+
+	return &u, nil
+}
+
+func putUpload(tx *bbolt.Tx, id string, u *upload) error {
+	uploadBytes, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("marshal upload: %w", err)
+	}
+
+	err = tx.Bucket(uploadsBucketName).Put([]byte(id), uploadBytes)
+	if err != nil {
+		return fmt.Errorf("put upload: %w", err)
+	}
+
+	return nil
+}
+
+// CreateUpload implements metastore.Store.
+func (s *store) CreateUpload(options metastore.NewUploadOptions) (*metastore.Upload, error) {
+	id, err := newUploadID()
+	if err != nil {
+		return nil, fmt.Errorf("generate upload id: %w", err)
+	}
+
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if tx.Bucket(rootBucketName).Bucket([]byte(options.Bucket)) == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	u := upload{
+		UpdatedAt: time.Now(),
+		CreatedAt: time.Now(),
+
+		Bucket:    options.Bucket,
+		Object:    options.Object,
+		Multipart: options.Multipart,
+
+		Attrs: fromAttrs(options.Attrs),
+		Checksums: expectedChecksums{
+			MD5:    options.Checksums.MD5,
+			CRC32C: options.Checksums.CRC32C,
+		},
+		Conditions: conditions{
+			IfGenerationMatch:        options.Conditions.IfGenerationMatch,
+			IfGenerationNotMatch:     options.Conditions.IfGenerationNotMatch,
+			IfMetagenerationMatch:    options.Conditions.IfMetagenerationMatch,
+			IfMetagenerationNotMatch: options.Conditions.IfMetagenerationNotMatch,
+		},
+	}
+
+	err = putUpload(tx, id, &u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit create upload: %w", err)
+	}
+
+	return u.upload(id), nil
+}
+
+// Upload implements metastore.Store.
+func (s *store) Upload(id string) (*metastore.Upload, error) {
+	tx, err := s.db.Begin(false)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := getUpload(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.upload(id), nil
+}
+
+// AppendUpload implements metastore.Store.
+func (s *store) AppendUpload(id string, options metastore.AppendUploadOptions) (*metastore.Upload, error) {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := getUpload(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Multipart || u.Finished != nil || u.Size != options.Offset {
+		return nil, metastore.ErrConflict
+	}
+
+	u.UpdatedAt = time.Now()
+	u.Chunks = append(u.Chunks, fromChunks(options.Chunks)...)
+	for _, c := range options.Chunks {
+		u.Size += c.Size
+	}
+	u.HashState = options.HashState
+
+	err = putUpload(tx, id, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit append upload: %w", err)
+	}
+
+	return u.upload(id), nil
+}
+
+// FinishUpload implements metastore.Store.
+func (s *store) FinishUpload(id string, options metastore.FinishUploadOptions) (*metastore.Object, error) {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := getUpload(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Finished != nil {
+		return u.Finished.object(u.Object), nil
+	}
+
+	if u.Multipart || u.Size != options.Size {
+		return nil, metastore.ErrConflict
+	}
+
+	if tx.Bucket(rootBucketName).Bucket([]byte(u.Bucket)) == nil {
+		return nil, metastore.ErrNotExist
+	}
+	b := &bucket{db: s.db, name: []byte(u.Bucket)}
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := b.objectMetadata(tx, []byte(u.Object))
+	if err != nil {
+		return nil, err
+	}
+
+	err = metadata.check(u.Conditions.conditions())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&objectVersion{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Chunks:         u.Chunks,
+		MD5:            options.MD5Sum,
+		CRC32C:         options.CRC32C,
+		Size:           u.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+
+		Attrs: u.Attrs,
+	}, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(u.Object), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chunks now belong to the object, the upload only remembers which
+	// object it created.
+	finished := *metadata.Current
+	finished.Chunks = nil
+	u.UpdatedAt = time.Now()
+	u.Chunks = nil
+	u.HashState = nil
+	u.Finished = &finished
+
+	err = putUpload(tx, id, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit finish upload: %w", err)
+	}
+
+	return metadata.Current.object(u.Object), nil
+}
+
+// PutUploadPart implements metastore.Store.
+func (s *store) PutUploadPart(id string, part metastore.UploadPart) (*metastore.Upload, error) {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := getUpload(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.Multipart {
+		return nil, metastore.ErrConflict
+	}
+
+	u.UpdatedAt = time.Now()
+	p := uploadPart{
+		Number:    part.Number,
+		UpdatedAt: u.UpdatedAt,
+
+		Chunks: fromChunks(part.Chunks),
+		MD5:    part.MD5Sum,
+		CRC32C: part.CRC32C,
+		Size:   part.Size,
+	}
+
+	// Parts are kept ordered by number.
+	i, found := slices.BinarySearchFunc(u.Parts, part.Number, func(p uploadPart, number int) int {
+		return p.Number - number
+	})
+	if found {
+		u.Parts[i] = p
+	} else {
+		u.Parts = slices.Insert(u.Parts, i, p)
+	}
+
+	err = putUpload(tx, id, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit put upload part: %w", err)
+	}
+
+	return u.upload(id), nil
+}
+
+// CompleteUpload implements metastore.Store.
+func (s *store) CompleteUpload(id string, options metastore.CompleteUploadOptions) (*metastore.Object, error) {
+	if len(options.Parts) == 0 {
+		return nil, fmt.Errorf("%w: no parts", metastore.ErrInvalidPart)
+	}
+
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return nil, fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	u, err := getUpload(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.Multipart {
+		return nil, metastore.ErrConflict
+	}
+
+	if tx.Bucket(rootBucketName).Bucket([]byte(u.Bucket)) == nil {
+		return nil, metastore.ErrNotExist
+	}
+	b := &bucket{db: s.db, name: []byte(u.Bucket)}
+
+	bucketMetadata, err := b.bucketMetadata(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	object := objectVersion{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: int64(len(options.Parts)),
+
+		Attrs: u.Attrs,
+	}
+
+	parts := make([]uploadPart, len(options.Parts))
+	for i, completed := range options.Parts {
+		if i > 0 && completed.Number <= options.Parts[i-1].Number {
+			return nil, metastore.ErrInvalidPartOrder
+		}
+
+		j, found := slices.BinarySearchFunc(u.Parts, completed.Number, func(p uploadPart, number int) int {
+			return p.Number - number
+		})
+		if !found || u.Parts[j].MD5 != completed.MD5Sum {
+			return nil, fmt.Errorf("%w: part %d", metastore.ErrInvalidPart, completed.Number)
+		}
+		parts[i] = u.Parts[j]
+	}
+
+	for i, part := range parts {
+		if i < len(parts)-1 && part.Size < metastore.MinUploadPartSize {
+			return nil, fmt.Errorf("%w: part %d", metastore.ErrPartTooSmall, part.Number)
+		}
+
+		object.Chunks = append(object.Chunks, part.Chunks...)
+		object.CRC32C = crc32c.Combine(object.CRC32C, part.CRC32C, part.Size)
+		object.Size += part.Size
+	}
+
+	metadata, err := b.objectMetadata(tx, []byte(u.Object))
+	if err != nil {
+		return nil, err
+	}
+
+	err = metadata.check(u.Conditions.conditions())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&object, bucketMetadata)
+
+	err = b.putObjectMetadata(tx, []byte(u.Object), &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Bucket(uploadsBucketName).Delete([]byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("delete upload: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("commit complete upload: %w", err)
+	}
+
+	return metadata.Current.object(u.Object), nil
+}
+
+// DeleteUpload implements metastore.Store.
+func (s *store) DeleteUpload(id string) error {
+	tx, err := s.db.Begin(true)
+	if err != nil {
+		return fmt.Errorf("begin db tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if tx.Bucket(uploadsBucketName).Get([]byte(id)) == nil {
+		return metastore.ErrNotExist
+	}
+
+	err = tx.Bucket(uploadsBucketName).Delete([]byte(id))
+	if err != nil {
+		return fmt.Errorf("delete upload: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("commit delete upload: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,839 @@
+package memory
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+type bucketState struct {
+	metadata metastore.BucketMetadata
+	objects  map[string]*objectMetadata
+}
+
+type objectMetadata struct {
+	current     *metastore.Object
+	nonCurrent  []metastore.Object
+	softDeleted []metastore.Object
+}
+
+// purgeExpired drops soft-deleted versions whose retention has expired, the
+// same way the bolt store does lazily when reading an object.
+func (m *objectMetadata) purgeExpired() {
+	now := time.Now()
+	m.softDeleted = slices.DeleteFunc(m.softDeleted, func(v metastore.Object) bool {
+		return !v.HardDeleteAt.After(now)
+	})
+}
+
+// version returns the live version of the object if generation is zero, or
+// else the live or noncurrent version with that generation. It returns nil if
+// there is no such version.
+func (m *objectMetadata) version(generation int64) *metastore.Object {
+	if m.current != nil && (generation == 0 || m.current.Generation == generation) {
+		return m.current
+	}
+	if generation == 0 {
+		return nil
+	}
+
+	i := slices.IndexFunc(m.nonCurrent, func(v metastore.Object) bool {
+		return v.Generation == generation
+	})
+	if i < 0 {
+		return nil
+	}
+	return &m.nonCurrent[i]
+}
+
+// empty reports whether no versions of the object are left.
+func (m *objectMetadata) empty() bool {
+	return m.current == nil && len(m.nonCurrent) == 0 && len(m.softDeleted) == 0
+}
+
+// setCurrent makes version the live version of the object. The previous live
+// version becomes noncurrent on versioned buckets, otherwise it's discarded.
+func (m *objectMetadata) setCurrent(version *metastore.Object, bucket *metastore.BucketMetadata) {
+	if m.current != nil {
+		if bucket.Versioning {
+			m.archive(*m.current)
+		} else {
+			m.discard(*m.current, bucket)
+		}
+	}
+	m.current = version
+}
+
+// archive keeps version as a noncurrent version of the object.
+func (m *objectMetadata) archive(version metastore.Object) {
+	version.DeletedAt = time.Now()
+	m.nonCurrent = append(m.nonCurrent, version)
+}
+
+// discard drops version from the object, keeping it around as soft-deleted if
+// the bucket has a soft delete policy.
+func (m *objectMetadata) discard(version metastore.Object, bucket *metastore.BucketMetadata) {
+	retention := bucket.SoftDeleteRetention
+	if retention <= 0 {
+		return
+	}
+
+	now := time.Now()
+	if version.DeletedAt.IsZero() {
+		version.DeletedAt = now
+	}
+	version.SoftDeletedAt = now
+	version.HardDeleteAt = now.Add(retention)
+	m.softDeleted = append(m.softDeleted, version)
+}
+
+// check checks conditions against the live version of the object.
+func (m *objectMetadata) check(conditions metastore.Conditions) error {
+	if m.current == nil {
+		return conditions.Check(0, 0)
+	}
+	return conditions.Check(m.current.Generation, m.current.Metageneration)
+}
+
+// listedVersions returns the versions of the object to include in a listing,
+// ordered by generation.
+func (m *objectMetadata) listedVersions(options metastore.ListObjectsOptions) []metastore.Object {
+	if options.SoftDeleted {
+		// Versions are soft deleted in any order, e.g. after restoring one.
+		versions := slices.Clone(m.softDeleted)
+		slices.SortFunc(versions, func(a, b metastore.Object) int {
+			return cmp.Compare(a.Generation, b.Generation)
+		})
+		return versions
+	}
+
+	var versions []metastore.Object
+	if options.Versions {
+		versions = append(versions, m.nonCurrent...)
+	}
+	if m.current != nil {
+		versions = append(versions, *m.current)
+	}
+	return versions
+}
+
+// cloneObject returns a copy of an object version which shares no memory with
+// the store.
+func cloneObject(object *metastore.Object) *metastore.Object {
+	clone := *object
+	clone.Chunks = slices.Clone(object.Chunks)
+	clone.Attrs = cloneAttrs(object.Attrs)
+	return &clone
+}
+
+func cloneAttrs(attrs metastore.ObjectAttrs) metastore.ObjectAttrs {
+	attrs.Metadata = maps.Clone(attrs.Metadata)
+	return attrs
+}
+
+// updateAttrs applies the non-nil fields of options to the attributes.
+func updateAttrs(a *metastore.ObjectAttrs, options metastore.UpdateObjectOptions) {
+	if options.ContentType != nil {
+		a.ContentType = *options.ContentType
+	}
+	if options.ContentEncoding != nil {
+		a.ContentEncoding = *options.ContentEncoding
+	}
+	if options.ContentDisposition != nil {
+		a.ContentDisposition = *options.ContentDisposition
+	}
+	if options.ContentLanguage != nil {
+		a.ContentLanguage = *options.ContentLanguage
+	}
+	if options.CacheControl != nil {
+		a.CacheControl = *options.CacheControl
+	}
+	if options.CustomTime != nil {
+		a.CustomTime = *options.CustomTime
+	}
+
+	if options.ReplaceMetadata {
+		a.Metadata = nil
+	}
+	for key, value := range options.Metadata {
+		if value == nil {
+			delete(a.Metadata, key)
+			continue
+		}
+		if a.Metadata == nil {
+			a.Metadata = make(map[string]string)
+		}
+		a.Metadata[key] = *value
+	}
+	if len(a.Metadata) == 0 {
+		a.Metadata = nil
+	}
+}
+
+// store keeps all metadata in memory behind a single lock, which stands in for
+// the transactions of the bolt store.
+type store struct {
+	mu      sync.Mutex
+	buckets map[string]*bucketState
+	uploads map[string]*metastore.Upload
+}
+
+var _ metastore.Store = (*store)(nil)
+
+type bucket struct {
+	store *store
+	name  string
+}
+
+var _ metastore.Bucket = (*bucket)(nil)
+
+func newGeneration() int64 {
+	return time.Now().UnixNano()
+}
+
+// New returns an empty metastore which keeps everything in memory. Its contents
+// are lost when the process exits.
+func New() metastore.Store {
+	return &store{
+		buckets: make(map[string]*bucketState),
+		uploads: make(map[string]*metastore.Upload),
+	}
+}
+
+// CreateBucket implements metastore.Store.
+func (s *store) CreateBucket(name string, options metastore.NewBucketOptions) (metastore.Bucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[name]; ok {
+		return nil, metastore.ErrAlreadyExists
+	}
+
+	s.buckets[name] = &bucketState{
+		metadata: metastore.BucketMetadata{
+			Name:    name,
+			Project: options.Project,
+
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+
+			Metageneration:      1,
+			Versioning:          options.Versioning,
+			SoftDeleteRetention: options.SoftDeleteRetention,
+		},
+		objects: make(map[string]*objectMetadata),
+	}
+
+	return &bucket{store: s, name: name}, nil
+}
+
+// DeleteBucket implements metastore.Store.
+func (s *store) DeleteBucket(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[name]
+	if !ok {
+		return metastore.ErrNotExist
+	}
+
+	// Soft-deleted objects don't prevent a bucket from being deleted.
+	for _, metadata := range b.objects {
+		if metadata.current != nil || len(metadata.nonCurrent) > 0 {
+			return metastore.ErrNotEmpty
+		}
+	}
+
+	delete(s.buckets, name)
+
+	return nil
+}
+
+// sortedKeys returns the keys of m in lexicographic order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// ListBuckets implements metastore.Store.
+func (s *store) ListBuckets(options metastore.ListBucketsOptions) (*metastore.BucketList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := max(options.Prefix, options.Cursor)
+
+	var list metastore.BucketList
+	for _, name := range sortedKeys(s.buckets) {
+		if name < start {
+			continue
+		}
+		if !strings.HasPrefix(name, options.Prefix) {
+			break
+		}
+
+		metadata := s.buckets[name].metadata
+		if options.Project != "" && metadata.Project != options.Project {
+			continue
+		}
+
+		if options.MaxResults > 0 && len(list.Buckets) >= options.MaxResults {
+			list.NextCursor = name
+			break
+		}
+
+		list.Buckets = append(list.Buckets, &metadata)
+	}
+
+	return &list, nil
+}
+
+// WalkChunkRefs implements metastore.Store.
+func (s *store) WalkChunkRefs(fn func(hash chunkstore.ChunkHash) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.buckets {
+		for _, metadata := range b.objects {
+			// Expired soft-deleted versions are no longer referenced.
+			metadata.purgeExpired()
+
+			versions := slices.Concat(metadata.nonCurrent, metadata.softDeleted)
+			if metadata.current != nil {
+				versions = append(versions, *metadata.current)
+			}
+			for _, version := range versions {
+				for _, c := range version.Chunks {
+					err := fn(c.Hash)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for _, u := range s.uploads {
+		chunks := slices.Clone(u.Chunks)
+		for _, p := range u.Parts {
+			chunks = append(chunks, p.Chunks...)
+		}
+		for _, c := range chunks {
+			err := fn(c.Hash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Bucket implements metastore.Store.
+func (s *store) Bucket(name string) (metastore.Bucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[name]; !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	return &bucket{store: s, name: name}, nil
+}
+
+// state returns the bucket's state. The store's lock must be held.
+func (b *bucket) state() (*bucketState, error) {
+	state, ok := b.store.buckets[b.name]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+	return state, nil
+}
+
+// objectMetadata returns the metadata of the named object, which is empty if
+// the object has no versions. The store's lock must be held.
+func (s *bucketState) objectMetadata(name string) *objectMetadata {
+	metadata, ok := s.objects[name]
+	if !ok {
+		return &objectMetadata{}
+	}
+
+	metadata.purgeExpired()
+	return metadata
+}
+
+// putObjectMetadata stores the object's metadata, removing it entirely once no
+// versions are left. The store's lock must be held.
+func (s *bucketState) putObjectMetadata(name string, metadata *objectMetadata) {
+	if metadata.empty() {
+		delete(s.objects, name)
+		return
+	}
+	s.objects[name] = metadata
+}
+
+// Metadata implements metastore.Bucket.
+func (b *bucket) Metadata() (*metastore.BucketMetadata, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.metadata
+	return &metadata, nil
+}
+
+// Update implements metastore.Bucket.
+func (b *bucket) Update(options metastore.UpdateBucketOptions) (*metastore.BucketMetadata, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Versioning != nil {
+		state.metadata.Versioning = *options.Versioning
+	}
+	if options.SoftDeleteRetention != nil {
+		state.metadata.SoftDeleteRetention = *options.SoftDeleteRetention
+	}
+	state.metadata.Metageneration++
+	state.metadata.UpdatedAt = time.Now()
+
+	metadata := state.metadata
+	return &metadata, nil
+}
+
+// Object implements metastore.Bucket.
+func (b *bucket) Object(name string) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.objectMetadata(name)
+	if metadata.current == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	return cloneObject(metadata.current), nil
+}
+
+// ObjectVersion implements metastore.Bucket.
+func (b *bucket) ObjectVersion(name string, generation int64) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.objectMetadata(name)
+	for _, version := range metadata.listedVersions(metastore.ListObjectsOptions{Versions: true}) {
+		if version.Generation == generation {
+			return cloneObject(&version), nil
+		}
+	}
+
+	return nil, metastore.ErrNotExist
+}
+
+// PutObject implements metastore.Bucket.
+func (b *bucket) PutObject(name string, options metastore.PutObjectOptions) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.objectMetadata(name)
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&metastore.Object{
+		Name: name,
+
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Chunks:         slices.Clone(options.Chunks),
+		MD5Sum:         options.MD5Sum,
+		CRC32C:         options.CRC32C,
+		Size:           options.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: options.ComponentCount,
+
+		Attrs: cloneAttrs(options.Attrs),
+	}, &state.metadata)
+	state.putObjectMetadata(name, metadata)
+
+	return cloneObject(metadata.current), nil
+}
+
+// ComposeObject implements metastore.Bucket.
+func (b *bucket) ComposeObject(name string, options metastore.ComposeObjectOptions) (*metastore.Object, error) {
+	if len(options.Sources) == 0 || len(options.Sources) > metastore.MaxComposeSources {
+		return nil, fmt.Errorf("compose %d sources: must be between 1 and %d", len(options.Sources), metastore.MaxComposeSources)
+	}
+
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	composite := metastore.Object{
+		Name: name,
+
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Generation:     newGeneration(),
+		Metageneration: 1,
+
+		Attrs: cloneAttrs(options.Attrs),
+	}
+
+	for _, source := range options.Sources {
+		version := state.objectMetadata(source.Name).version(source.Generation)
+		if version == nil {
+			return nil, metastore.ErrNotExist
+		}
+
+		err = source.Conditions.Check(version.Generation, version.Metageneration)
+		if err != nil {
+			return nil, err
+		}
+
+		composite.Chunks = append(composite.Chunks, version.Chunks...)
+		composite.CRC32C = crc32c.Combine(composite.CRC32C, version.CRC32C, version.Size)
+		composite.Size += version.Size
+		composite.ComponentCount += max(version.ComponentCount, 1)
+	}
+
+	metadata := state.objectMetadata(name)
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&composite, &state.metadata)
+	state.putObjectMetadata(name, metadata)
+
+	return cloneObject(metadata.current), nil
+}
+
+// CopyObject implements metastore.Bucket.
+func (b *bucket) CopyObject(name string, options metastore.CopyObjectOptions) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	sourceState, ok := b.store.buckets[options.SourceBucket]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	version := sourceState.objectMetadata(options.SourceName).version(options.SourceGeneration)
+	if version == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = options.SourceConditions.Check(version.Generation, version.Metageneration)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := version.Attrs
+	if options.Attrs != nil {
+		attrs = *options.Attrs
+	}
+
+	metadata := state.objectMetadata(name)
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&metastore.Object{
+		Name: name,
+
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Chunks:         slices.Clone(version.Chunks),
+		MD5Sum:         version.MD5Sum,
+		CRC32C:         version.CRC32C,
+		Size:           version.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: version.ComponentCount,
+
+		Attrs: cloneAttrs(attrs),
+	}, &state.metadata)
+	state.putObjectMetadata(name, metadata)
+
+	return cloneObject(metadata.current), nil
+}
+
+// DeleteObject implements metastore.Bucket.
+func (b *bucket) DeleteObject(name string, options metastore.DeleteObjectOptions) error {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return err
+	}
+
+	metadata := state.objectMetadata(name)
+	if options.Generation != 0 && (metadata.current == nil || metadata.current.Generation != options.Generation) {
+		// Deleting a specific noncurrent version removes it for good, unless
+		// it is retained by a soft delete policy.
+		i := slices.IndexFunc(metadata.nonCurrent, func(v metastore.Object) bool {
+			return v.Generation == options.Generation
+		})
+		if i < 0 {
+			return metastore.ErrNotExist
+		}
+
+		version := metadata.nonCurrent[i]
+		err = options.Conditions.Check(version.Generation, version.Metageneration)
+		if err != nil {
+			return err
+		}
+
+		metadata.nonCurrent = slices.Delete(metadata.nonCurrent, i, i+1)
+		metadata.discard(version, &state.metadata)
+	} else {
+		if metadata.current == nil {
+			return metastore.ErrNotExist
+		}
+
+		err = metadata.check(options.Conditions)
+		if err != nil {
+			return err
+		}
+
+		// Deleting the live version by its generation discards it, otherwise
+		// it's kept as a noncurrent version on versioned buckets.
+		if state.metadata.Versioning && options.Generation == 0 {
+			metadata.archive(*metadata.current)
+		} else {
+			metadata.discard(*metadata.current, &state.metadata)
+		}
+		metadata.current = nil
+	}
+
+	state.putObjectMetadata(name, metadata)
+
+	return nil
+}
+
+// UpdateObject implements metastore.Bucket.
+func (b *bucket) UpdateObject(name string, options metastore.UpdateObjectOptions) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	version := state.objectMetadata(name).version(options.Generation)
+	if version == nil {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = options.Conditions.Check(version.Generation, version.Metageneration)
+	if err != nil {
+		return nil, err
+	}
+
+	updateAttrs(&version.Attrs, options)
+	version.Metageneration++
+	version.UpdatedAt = time.Now()
+
+	return cloneObject(version), nil
+}
+
+// RestoreObject implements metastore.Bucket.
+func (b *bucket) RestoreObject(name string, options metastore.RestoreObjectOptions) (*metastore.Object, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.objectMetadata(name)
+	i := slices.IndexFunc(metadata.softDeleted, func(v metastore.Object) bool {
+		return v.Generation == options.Generation
+	})
+	if i < 0 {
+		return nil, metastore.ErrNotExist
+	}
+
+	err = metadata.check(options.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The restored object is a copy of the soft-deleted version with a new
+	// generation.
+	version := metadata.softDeleted[i]
+	metadata.softDeleted = slices.Delete(metadata.softDeleted, i, i+1)
+
+	version.Generation = newGeneration()
+	version.Metageneration = 1
+	version.CreatedAt = time.Now()
+	version.UpdatedAt = time.Now()
+	version.DeletedAt = time.Time{}
+	version.SoftDeletedAt = time.Time{}
+	version.HardDeleteAt = time.Time{}
+	metadata.setCurrent(&version, &state.metadata)
+	state.putObjectMetadata(name, metadata)
+
+	return cloneObject(metadata.current), nil
+}
+
+// versionCursor encodes a listing position within the versions of an object.
+func versionCursor(name string, generation int64) string {
+	return name + "\x00" + strconv.FormatInt(generation, 10)
+}
+
+func parseCursor(cursor string) (string, int64) {
+	name, generation, ok := strings.Cut(cursor, "\x00")
+	if !ok {
+		return cursor, 0
+	}
+	gen, _ := strconv.ParseInt(generation, 10, 64)
+	return name, gen
+}
+
+// ListObjects implements metastore.Bucket.
+func (b *bucket) ListObjects(options metastore.ListObjectsOptions) (*metastore.ObjectList, error) {
+	b.store.mu.Lock()
+	defer b.store.mu.Unlock()
+
+	state, err := b.state()
+	if err != nil {
+		return nil, err
+	}
+
+	cursorName, cursorGeneration := parseCursor(options.Cursor)
+	start := max(options.Prefix, options.StartOffset, cursorName)
+
+	var list metastore.ObjectList
+	var count int
+	// full reports whether n more results would exceed MaxResults. At least
+	// one result is always listed, so listings make progress.
+	full := func(n int) bool {
+		return options.MaxResults > 0 && count > 0 && count+n > options.MaxResults
+	}
+
+	// rolledUp is the last prefix added, everything under it is skipped.
+	var rolledUp string
+	for _, name := range sortedKeys(state.objects) {
+		if name < start {
+			continue
+		}
+		if rolledUp != "" && strings.HasPrefix(name, rolledUp) {
+			continue
+		}
+		if !strings.HasPrefix(name, options.Prefix) {
+			break
+		}
+		if options.EndOffset != "" && name >= options.EndOffset {
+			break
+		}
+
+		versions := state.objectMetadata(name).listedVersions(options)
+		if len(versions) == 0 {
+			continue
+		}
+
+		if options.Delimiter != "" {
+			rest := name[len(options.Prefix):]
+			if i := strings.Index(rest, options.Delimiter); i >= 0 {
+				prefix := options.Prefix + rest[:i+len(options.Delimiter)]
+
+				var prefixObjects []*metastore.Object
+				if options.IncludeTrailingDelimiter {
+					for _, version := range state.objectMetadata(prefix).listedVersions(options) {
+						prefixObjects = append(prefixObjects, cloneObject(&version))
+					}
+				}
+
+				// The prefix and the objects named like it can't be split
+				// across pages, so they're counted together.
+				if full(1 + len(prefixObjects)) {
+					list.NextCursor = name
+					break
+				}
+				count += 1 + len(prefixObjects)
+
+				list.Prefixes = append(list.Prefixes, prefix)
+				list.Objects = append(list.Objects, prefixObjects...)
+
+				rolledUp = prefix
+				continue
+			}
+		}
+
+		for _, version := range versions {
+			if name == cursorName && version.Generation < cursorGeneration {
+				continue
+			}
+
+			if full(1) {
+				list.NextCursor = name
+				if options.Versions || options.SoftDeleted {
+					list.NextCursor = versionCursor(name, version.Generation)
+				}
+				break
+			}
+			count++
+
+			list.Objects = append(list.Objects, cloneObject(&version))
+		}
+		if list.NextCursor != "" {
+			break
+		}
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,282 @@
+package memory
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+func newUploadID() (string, error) {
+	var id [16]byte
+	_, err := rand.Read(id[:])
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id[:]), nil
+}
+
+// cloneUpload returns a copy of an upload which shares no memory with the
+// store.
+func cloneUpload(u *metastore.Upload) *metastore.Upload {
+	clone := *u
+	clone.Chunks = slices.Clone(u.Chunks)
+	clone.HashState = slices.Clone(u.HashState)
+	clone.Parts = slices.Clone(u.Parts)
+	for i, part := range clone.Parts {
+		clone.Parts[i].Chunks = slices.Clone(part.Chunks)
+	}
+	if u.Finished != nil {
+		clone.Finished = cloneObject(u.Finished)
+	}
+	clone.Attrs = cloneAttrs(u.Attrs)
+	return &clone
+}
+
+// CreateUpload implements metastore.Store.
+func (s *store) CreateUpload(options metastore.NewUploadOptions) (*metastore.Upload, error) {
+	id, err := newUploadID()
+	if err != nil {
+		return nil, fmt.Errorf("generate upload id: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.buckets[options.Bucket]; !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	u := &metastore.Upload{
+		ID:        id,
+		Bucket:    options.Bucket,
+		Object:    options.Object,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Multipart: options.Multipart,
+
+		Attrs:      cloneAttrs(options.Attrs),
+		Checksums:  options.Checksums,
+		Conditions: options.Conditions,
+	}
+	s.uploads[id] = u
+
+	return cloneUpload(u), nil
+}
+
+// Upload implements metastore.Store.
+func (s *store) Upload(id string) (*metastore.Upload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	return cloneUpload(u), nil
+}
+
+// AppendUpload implements metastore.Store.
+func (s *store) AppendUpload(id string, options metastore.AppendUploadOptions) (*metastore.Upload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	if u.Multipart || u.Finished != nil || u.Size != options.Offset {
+		return nil, metastore.ErrConflict
+	}
+
+	u.UpdatedAt = time.Now()
+	u.Chunks = append(u.Chunks, options.Chunks...)
+	for _, c := range options.Chunks {
+		u.Size += c.Size
+	}
+	u.HashState = slices.Clone(options.HashState)
+
+	return cloneUpload(u), nil
+}
+
+// FinishUpload implements metastore.Store.
+func (s *store) FinishUpload(id string, options metastore.FinishUploadOptions) (*metastore.Object, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	if u.Finished != nil {
+		return cloneObject(u.Finished), nil
+	}
+
+	if u.Multipart || u.Size != options.Size {
+		return nil, metastore.ErrConflict
+	}
+
+	state, ok := s.buckets[u.Bucket]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	metadata := state.objectMetadata(u.Object)
+	err := metadata.check(u.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(&metastore.Object{
+		Name: u.Object,
+
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Chunks:         slices.Clone(u.Chunks),
+		MD5Sum:         options.MD5Sum,
+		CRC32C:         options.CRC32C,
+		Size:           u.Size,
+		Generation:     newGeneration(),
+		Metageneration: 1,
+
+		Attrs: cloneAttrs(u.Attrs),
+	}, &state.metadata)
+	state.putObjectMetadata(u.Object, metadata)
+
+	// The chunks now belong to the object, the upload only remembers which
+	// object it created.
+	u.UpdatedAt = time.Now()
+	u.Chunks = nil
+	u.HashState = nil
+	u.Finished = cloneObject(metadata.current)
+	u.Finished.Chunks = nil
+
+	return cloneObject(metadata.current), nil
+}
+
+// PutUploadPart implements metastore.Store.
+func (s *store) PutUploadPart(id string, part metastore.UploadPart) (*metastore.Upload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	if !u.Multipart {
+		return nil, metastore.ErrConflict
+	}
+
+	u.UpdatedAt = time.Now()
+	part.UpdatedAt = u.UpdatedAt
+	part.Chunks = slices.Clone(part.Chunks)
+
+	// Parts are kept ordered by number.
+	i, found := slices.BinarySearchFunc(u.Parts, part.Number, func(p metastore.UploadPart, number int) int {
+		return p.Number - number
+	})
+	if found {
+		u.Parts[i] = part
+	} else {
+		u.Parts = slices.Insert(u.Parts, i, part)
+	}
+
+	return cloneUpload(u), nil
+}
+
+// CompleteUpload implements metastore.Store.
+func (s *store) CompleteUpload(id string, options metastore.CompleteUploadOptions) (*metastore.Object, error) {
+	if len(options.Parts) == 0 {
+		return nil, fmt.Errorf("%w: no parts", metastore.ErrInvalidPart)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[id]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	if !u.Multipart {
+		return nil, metastore.ErrConflict
+	}
+
+	state, ok := s.buckets[u.Bucket]
+	if !ok {
+		return nil, metastore.ErrNotExist
+	}
+
+	object := &metastore.Object{
+		Name: u.Object,
+
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+
+		Generation:     newGeneration(),
+		Metageneration: 1,
+		ComponentCount: int64(len(options.Parts)),
+
+		Attrs: cloneAttrs(u.Attrs),
+	}
+
+	parts := make([]metastore.UploadPart, len(options.Parts))
+	for i, completed := range options.Parts {
+		if i > 0 && completed.Number <= options.Parts[i-1].Number {
+			return nil, metastore.ErrInvalidPartOrder
+		}
+
+		j, found := slices.BinarySearchFunc(u.Parts, completed.Number, func(p metastore.UploadPart, number int) int {
+			return p.Number - number
+		})
+		if !found || u.Parts[j].MD5Sum != completed.MD5Sum {
+			return nil, fmt.Errorf("%w: part %d", metastore.ErrInvalidPart, completed.Number)
+		}
+		parts[i] = u.Parts[j]
+	}
+
+	for i, part := range parts {
+		if i < len(parts)-1 && part.Size < metastore.MinUploadPartSize {
+			return nil, fmt.Errorf("%w: part %d", metastore.ErrPartTooSmall, part.Number)
+		}
+
+		object.Chunks = append(object.Chunks, part.Chunks...)
+		object.CRC32C = crc32c.Combine(object.CRC32C, part.CRC32C, part.Size)
+		object.Size += part.Size
+	}
+
+	metadata := state.objectMetadata(u.Object)
+	err := metadata.check(u.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.setCurrent(object, &state.metadata)
+	state.putObjectMetadata(u.Object, metadata)
+	delete(s.uploads, id)
+
+	return cloneObject(metadata.current), nil
+}
+
+// DeleteUpload implements metastore.Store.
+func (s *store) DeleteUpload(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.uploads[id]; !ok {
+		return metastore.ErrNotExist
+	}
+	delete(s.uploads, id)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package metastore
 import (
 	"crypto/md5"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
@@ -11,43 +12,425 @@ import (
 var (
 	ErrNotExist      = errors.New("does not exist")
 	ErrAlreadyExists = errors.New("already exists")
+	ErrNotEmpty      = errors.New("not empty")
+	ErrConflict      = errors.New("conflict")
+
+	// ErrInvalidPart is returned when completing a multipart upload with a
+	// part which wasn't uploaded, or whose MD5 doesn't match.
+	ErrInvalidPart = errors.New("invalid part")
+	// ErrInvalidPartOrder is returned when completing a multipart upload with
+	// parts which aren't in ascending order.
+	ErrInvalidPartOrder = errors.New("invalid part order")
+	// ErrPartTooSmall is returned when completing a multipart upload with a
+	// part other than the last which is smaller than MinUploadPartSize.
+	ErrPartTooSmall = errors.New("part too small")
 )
 
 type Store interface {
 	Bucket(name string) (Bucket, error)
 	CreateBucket(name string, options NewBucketOptions) (Bucket, error)
 	DeleteBucket(name string) error
+	ListBuckets(options ListBucketsOptions) (*BucketList, error)
+
+	CreateUpload(options NewUploadOptions) (*Upload, error)
+	Upload(id string) (*Upload, error)
+	AppendUpload(id string, options AppendUploadOptions) (*Upload, error)
+	DeleteUpload(id string) error
+	// FinishUpload creates the object from a resumable upload, within a
+	// single transaction. The upload is kept, without its data, so finishing
+	// it again returns the same object. ErrConflict is returned if the upload
+	// no longer has the expected size.
+	FinishUpload(id string, options FinishUploadOptions) (*Object, error)
+	// PutUploadPart adds a part to a multipart upload, replacing any part
+	// with the same number. ErrConflict is returned for resumable uploads,
+	// as it is when appending to a multipart upload.
+	PutUploadPart(id string, part UploadPart) (*Upload, error)
+	// CompleteUpload creates the object from the given parts of a multipart
+	// upload and deletes the upload, all within a single transaction.
+	CompleteUpload(id string, options CompleteUploadOptions) (*Object, error)
+
+	// WalkChunkRefs calls fn for every chunk referenced by an object version
+	// or an in-progress upload. Chunks may be visited more than once.
+	WalkChunkRefs(fn func(hash chunkstore.ChunkHash) error) error
 }
 
 type Bucket interface {
 	Metadata() (*BucketMetadata, error)
+	Update(options UpdateBucketOptions) (*BucketMetadata, error)
 	Object(name string) (*Object, error)
+	// ObjectVersion returns a specific generation of an object, which may be
+	// noncurrent.
+	ObjectVersion(name string, generation int64) (*Object, error)
 	PutObject(name string, options PutObjectOptions) (*Object, error)
+	// ComposeObject creates an object from the concatenated contents of other
+	// objects in the bucket, all within a single transaction.
+	ComposeObject(name string, options ComposeObjectOptions) (*Object, error)
+	// CopyObject creates an object from a version of another object, which
+	// may be in another bucket, within a single transaction.
+	CopyObject(name string, options CopyObjectOptions) (*Object, error)
+	DeleteObject(name string, options DeleteObjectOptions) error
+	// UpdateObject changes the attributes of an object without touching its
+	// contents, bumping its metageneration.
+	UpdateObject(name string, options UpdateObjectOptions) (*Object, error)
+	// RestoreObject makes a soft-deleted version of an object live again.
+	RestoreObject(name string, options RestoreObjectOptions) (*Object, error)
+	ListObjects(options ListObjectsOptions) (*ObjectList, error)
 }
 
 type NewBucketOptions struct {
+	Project    string
 	Versioning bool
+	// SoftDeleteRetention is how long deleted objects are retained for, zero
+	// disables soft delete.
+	SoftDeleteRetention time.Duration
+}
+
+// UpdateBucketOptions changes the bucket's configuration. Nil fields are left
+// unchanged.
+type UpdateBucketOptions struct {
+	Versioning          *bool
+	SoftDeleteRetention *time.Duration
+}
+
+type ListBucketsOptions struct {
+	// Project restricts results to buckets created in the project.
+	Project string
+	// Prefix restricts results to buckets whose names start with it.
+	Prefix string
+	// Cursor resumes a listing from the NextCursor of a previous call.
+	Cursor string
+	// MaxResults limits the number of buckets returned. Zero means no limit.
+	MaxResults int
+}
+
+type BucketList struct {
+	Buckets []*BucketMetadata
+	// NextCursor is set if there are more results to list.
+	NextCursor string
 }
 
 type PutObjectOptions struct {
-	Chunks []chunkstore.ChunkHash
+	Chunks []Chunk
 	MD5Sum [md5.Size]byte
+	CRC32C uint32
+	Size   int64
+	// ComponentCount is set when copying a composite object.
+	ComponentCount int64
+	Attrs          ObjectAttrs
+
+	Conditions Conditions
+}
+
+// MaxComposeSources is the maximum number of objects which can be composed in
+// one request.
+const MaxComposeSources = 32
+
+type ComposeObjectOptions struct {
+	// Sources are concatenated in order. There must be between one and
+	// MaxComposeSources of them.
+	Sources []ComposeSource
+	Attrs   ObjectAttrs
+
+	// Conditions are checked against the destination object.
+	Conditions Conditions
+}
+
+type ComposeSource struct {
+	Name string
+	// Generation, if set, selects a specific version of the source rather than
+	// the live version.
+	Generation int64
+	// Conditions are checked against the selected version of the source.
+	Conditions Conditions
+}
+
+type CopyObjectOptions struct {
+	SourceBucket string
+	SourceName   string
+	// SourceGeneration, if set, selects a specific version of the source
+	// rather than the live version.
+	SourceGeneration int64
+	// SourceConditions are checked against the selected version of the
+	// source.
+	SourceConditions Conditions
+	// Attrs, if set, are the attributes the copy is created with instead of
+	// those of the source.
+	Attrs *ObjectAttrs
+
+	// Conditions are checked against the destination object.
+	Conditions Conditions
+}
+
+type DeleteObjectOptions struct {
+	// Generation, if set, permanently deletes that version of the object
+	// rather than the live version.
+	Generation int64
+	Conditions Conditions
+}
+
+// UpdateObjectOptions changes the attributes of an object. Nil fields are
+// left unchanged.
+type UpdateObjectOptions struct {
+	// Generation, if set, updates that version of the object rather than the
+	// live version.
+	Generation int64
+
+	ContentType        *string
+	ContentEncoding    *string
+	ContentDisposition *string
+	ContentLanguage    *string
+	CacheControl       *string
+	CustomTime         *time.Time
+	// Metadata is merged into the object's custom metadata. Keys with a nil
+	// value are removed.
+	Metadata map[string]*string
+	// ReplaceMetadata removes all existing custom metadata before Metadata is
+	// merged.
+	ReplaceMetadata bool
+
+	Conditions Conditions
+}
+
+type RestoreObjectOptions struct {
+	// Generation is the soft-deleted version to restore.
+	Generation int64
+	// Conditions are checked against the live version of the object.
+	Conditions Conditions
+}
+
+// Conditions are preconditions on the live version of an object which must
+// hold for an operation to proceed. Unset conditions are ignored.
+type Conditions struct {
+	// IfGenerationMatch of zero requires that the object does not exist.
+	IfGenerationMatch        *int64
+	IfGenerationNotMatch     *int64
+	IfMetagenerationMatch    *int64
+	IfMetagenerationNotMatch *int64
+}
+
+// PreconditionError is returned when a condition does not hold.
+type PreconditionError struct {
+	// Condition is the name of the condition which failed, e.g.
+	// "ifGenerationMatch".
+	Condition string
+}
+
+func (e *PreconditionError) Error() string {
+	return fmt.Sprintf("precondition failed: %s", e.Condition)
+}
+
+// Check checks the conditions against the live version of an object. A
+// generation of zero means the object does not exist.
+func (c Conditions) Check(generation, metageneration int64) error {
+	if c.IfGenerationMatch != nil && *c.IfGenerationMatch != generation {
+		return &PreconditionError{Condition: "ifGenerationMatch"}
+	}
+	if c.IfGenerationNotMatch != nil && *c.IfGenerationNotMatch == generation {
+		return &PreconditionError{Condition: "ifGenerationNotMatch"}
+	}
+	if c.IfMetagenerationMatch != nil && *c.IfMetagenerationMatch != metageneration {
+		return &PreconditionError{Condition: "ifMetagenerationMatch"}
+	}
+	if c.IfMetagenerationNotMatch != nil && *c.IfMetagenerationNotMatch == metageneration {
+		return &PreconditionError{Condition: "ifMetagenerationNotMatch"}
+	}
+	return nil
+}
+
+type ListObjectsOptions struct {
+	// Prefix restricts results to objects whose names start with it.
+	Prefix string
+	// Delimiter, if set, rolls up objects whose names contain it after the
+	// prefix into a single entry in Prefixes.
+	Delimiter string
+	// IncludeTrailingDelimiter also returns objects whose names end with the
+	// delimiter and which have been rolled up into a prefix.
+	IncludeTrailingDelimiter bool
+	// StartOffset and EndOffset restrict results to names in the range
+	// [StartOffset, EndOffset).
+	StartOffset string
+	EndOffset   string
+	// Cursor resumes a listing from the NextCursor of a previous call.
+	Cursor string
+	// MaxResults limits the number of objects plus prefixes returned. Zero
+	// means no limit. A prefix is only listed along with the objects
+	// IncludeTrailingDelimiter adds for it, so a page may hold fewer results
+	// than the limit, or more if the first prefix alone exceeds it.
+	MaxResults int
+	// Versions includes noncurrent versions of objects.
+	Versions bool
+	// SoftDeleted lists only soft-deleted versions of objects.
+	SoftDeleted bool
+}
+
+type ObjectList struct {
+	Objects  []*Object
+	Prefixes []string
+	// NextCursor is set if there are more results to list.
+	NextCursor string
+}
+
+// PrefixEnd returns the smallest name which is greater than every name
+// starting with prefix, or "" if there is no such name. Listings use it to
+// skip over everything rolled up into a prefix.
+func PrefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	return ""
 }
 
 type BucketMetadata struct {
+	Name    string
+	Project string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	Versioning bool
+	Metageneration      int64
+	Versioning          bool
+	SoftDeleteRetention time.Duration
 }
 
 type Object struct {
+	Name string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
 
-	Chunks         []chunkstore.ChunkHash
+	SoftDeletedAt time.Time
+	HardDeleteAt  time.Time
+
+	Chunks []Chunk
+	// MD5Sum is zero for composite objects.
 	MD5Sum         [md5.Size]byte
+	CRC32C         uint32
+	Size           int64
 	Generation     int64
 	Metageneration int64
+	// ComponentCount is the number of non-composite objects a composite
+	// object was built from, or zero if the object is not composite.
+	ComponentCount int64
+
+	Attrs ObjectAttrs
+}
+
+// ObjectAttrs are the user-settable attributes of an object.
+type ObjectAttrs struct {
+	ContentType        string
+	ContentEncoding    string
+	ContentDisposition string
+	ContentLanguage    string
+	CacheControl       string
+	CustomTime         time.Time
+	// Metadata is arbitrary user-provided key/value metadata.
+	Metadata map[string]string
+}
+
+// Chunk is a reference to a chunk in the chunk store which makes up part of an
+// object's contents.
+type Chunk struct {
+	Hash   chunkstore.ChunkHash
+	Size   int64
+	CRC32C uint32
+}
+
+// ExpectedChecksums are checksums of an object's contents supplied by a
+// client, which are verified before the object is created. Nil checksums are
+// not checked.
+type ExpectedChecksums struct {
+	MD5    *[md5.Size]byte
+	CRC32C *uint32
+}
+
+type NewUploadOptions struct {
+	Bucket string
+	Object string
+	Attrs  ObjectAttrs
+
+	// Checksums and Conditions are checked when the upload is finished.
+	Checksums  ExpectedChecksums
+	Conditions Conditions
+
+	// Multipart uploads are made of numbered parts rather than appended
+	// segments.
+	Multipart bool
+}
+
+type AppendUploadOptions struct {
+	// Offset is the size the upload is expected to have before appending. If
+	// it does not match, ErrConflict is returned.
+	Offset    int64
+	Chunks    []Chunk
+	HashState []byte
+}
+
+type FinishUploadOptions struct {
+	// Size is the size the upload is expected to have, which the checksums
+	// were computed over.
+	Size   int64
+	MD5Sum [md5.Size]byte
+	CRC32C uint32
+}
+
+// Upload is an in-progress resumable or multipart upload. Each appended
+// segment of data or part is stored as separate chunks.
+type Upload struct {
+	ID        string
+	Bucket    string
+	Object    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Multipart is set for multipart uploads, whose data is in Parts rather
+	// than Chunks.
+	Multipart bool
+	// Parts are the parts of a multipart upload, ordered by number.
+	Parts []UploadPart
+
+	Chunks []Chunk
+	Size   int64
+	// HashState is the opaque, serialized state of the hashes computed over
+	// the data uploaded so far.
+	HashState []byte
+
+	// Finished is the object created when a resumable upload was finished,
+	// without its chunks. The upload has no data left once finished.
+	Finished *Object
+
+	Attrs      ObjectAttrs
+	Checksums  ExpectedChecksums
+	Conditions Conditions
+}
+
+// MinUploadPartSize is the minimum size of every part of a completed multipart
+// upload except the last.
+const MinUploadPartSize = 5 << 20
+
+type CompleteUploadOptions struct {
+	// Parts are concatenated in order. They must be in ascending order of
+	// number, and match the MD5 hash of the uploaded part.
+	Parts []CompletedPart
+}
+
+// CompletedPart identifies an uploaded part to include in the object.
+type CompletedPart struct {
+	Number int
+	MD5Sum [md5.Size]byte
+}
+
+// UploadPart is a numbered part of a multipart upload.
+type UploadPart struct {
+	Number    int
+	UpdatedAt time.Time
+
+	Chunks []Chunk
+	MD5Sum [md5.Size]byte
+	CRC32C uint32
+	Size   int64
 }
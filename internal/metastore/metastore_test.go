@@ -3,9 +3,11 @@ package metastore_test
 import (
 	"crypto/md5"
 	"crypto/sha256"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/shoenig/test/must"
@@ -13,6 +15,7 @@ import (
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
 	"github.com/cbrewster/gcs-emulator/internal/metastore"
 	"github.com/cbrewster/gcs-emulator/internal/metastore/bolt"
+	"github.com/cbrewster/gcs-emulator/internal/metastore/memory"
 )
 
 func newBoltStore(t *testing.T) metastore.Store {
@@ -28,12 +31,19 @@ func newBoltStore(t *testing.T) metastore.Store {
 	return store
 }
 
+func newMemoryStore(t *testing.T) metastore.Store {
+	return memory.New()
+}
+
 var testCases = []struct {
 	name  string
 	store func(t *testing.T) metastore.Store
 }{{
 	name:  "bolt",
 	store: newBoltStore,
+}, {
+	name:  "memory",
+	store: newMemoryStore,
 }}
 
 var ignoreBucketTimestamps = must.Cmp(cmpopts.IgnoreFields(metastore.BucketMetadata{}, "CreatedAt", "UpdatedAt"))
@@ -48,7 +58,7 @@ func TestCreateBucket(t *testing.T) {
 
 			metadata, err := bucket.Metadata()
 			must.NoError(t, err)
-			must.Eq(t, &metastore.BucketMetadata{}, metadata, ignoreBucketTimestamps)
+			must.Eq(t, &metastore.BucketMetadata{Name: "test-bucket", Metageneration: 1}, metadata, ignoreBucketTimestamps)
 
 			_, err = store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
 			must.ErrorIs(t, err, metastore.ErrAlreadyExists)
@@ -60,7 +70,7 @@ func TestCreateBucket(t *testing.T) {
 
 			metadata, err = bucket.Metadata()
 			must.NoError(t, err)
-			must.Eq(t, &metastore.BucketMetadata{Versioning: true}, metadata, ignoreBucketTimestamps)
+			must.Eq(t, &metastore.BucketMetadata{Name: "versioned-bucket", Metageneration: 1, Versioning: true}, metadata, ignoreBucketTimestamps)
 		})
 	}
 }
@@ -74,8 +84,9 @@ func TestCreateObjects(t *testing.T) {
 			must.NoError(t, err)
 
 			putRes, err := bucket.PutObject("foo", metastore.PutObjectOptions{
-				Chunks: []chunkstore.ChunkHash{sha256.Sum256([]byte("phony"))},
+				Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte("phony")), Size: 5}},
 				MD5Sum: md5.Sum([]byte("phony")),
+				Size:   5,
 			})
 			must.NoError(t, err)
 			must.NotEq(t, 0, putRes.Generation)
@@ -86,8 +97,9 @@ func TestCreateObjects(t *testing.T) {
 			must.Eq(t, putRes, getRes)
 
 			putRes, err = bucket.PutObject("foo", metastore.PutObjectOptions{
-				Chunks: []chunkstore.ChunkHash{sha256.Sum256([]byte("phony"))},
+				Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte("phony")), Size: 5}},
 				MD5Sum: md5.Sum([]byte("phony")),
+				Size:   5,
 			})
 			must.NoError(t, err)
 			must.NotEq(t, getRes, putRes)
@@ -100,3 +112,916 @@ func TestCreateObjects(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteObjects(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			_, err = bucket.PutObject("foo", metastore.PutObjectOptions{
+				Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte("phony")), Size: 5}},
+				MD5Sum: md5.Sum([]byte("phony")),
+				Size:   5,
+			})
+			must.NoError(t, err)
+
+			err = store.DeleteBucket("test-bucket")
+			must.ErrorIs(t, err, metastore.ErrNotEmpty)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			_, err = bucket.Object("foo")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			err = store.DeleteBucket("test-bucket")
+			must.NoError(t, err)
+
+			err = store.DeleteBucket("test-bucket")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestUploads(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			_, err := store.CreateUpload(metastore.NewUploadOptions{Bucket: "missing", Object: "foo"})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload, err := store.CreateUpload(metastore.NewUploadOptions{Bucket: "test-bucket", Object: "foo"})
+			must.NoError(t, err)
+			must.NotEq(t, "", upload.ID)
+			must.Eq(t, 0, upload.Size)
+
+			chunk := metastore.Chunk{Hash: sha256.Sum256([]byte("phony")), Size: 5}
+			appended, err := store.AppendUpload(upload.ID, metastore.AppendUploadOptions{
+				Offset:    0,
+				Chunks:    []metastore.Chunk{chunk},
+				HashState: []byte("state"),
+			})
+			must.NoError(t, err)
+			must.Eq(t, 5, appended.Size)
+			must.Eq(t, []metastore.Chunk{chunk}, appended.Chunks)
+
+			_, err = store.AppendUpload(upload.ID, metastore.AppendUploadOptions{Offset: 0, Chunks: []metastore.Chunk{chunk}})
+			must.ErrorIs(t, err, metastore.ErrConflict)
+
+			got, err := store.Upload(upload.ID)
+			must.NoError(t, err)
+			must.Eq(t, appended, got)
+
+			err = store.DeleteUpload(upload.ID)
+			must.NoError(t, err)
+
+			_, err = store.Upload(upload.ID)
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestFinishUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			zero := int64(0)
+			upload, err := store.CreateUpload(metastore.NewUploadOptions{
+				Bucket:     "test-bucket",
+				Object:     "foo",
+				Attrs:      metastore.ObjectAttrs{ContentType: "text/plain"},
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.NoError(t, err)
+
+			chunk := metastore.Chunk{Hash: sha256.Sum256([]byte("phony")), Size: 5}
+			_, err = store.AppendUpload(upload.ID, metastore.AppendUploadOptions{Offset: 0, Chunks: []metastore.Chunk{chunk}})
+			must.NoError(t, err)
+
+			// The checksums were computed over a different size.
+			_, err = store.FinishUpload(upload.ID, metastore.FinishUploadOptions{Size: 3})
+			must.ErrorIs(t, err, metastore.ErrConflict)
+
+			created, err := store.FinishUpload(upload.ID, metastore.FinishUploadOptions{Size: 5, CRC32C: 42})
+			must.NoError(t, err)
+			must.Eq(t, 5, created.Size)
+			must.Eq(t, 42, created.CRC32C)
+			must.Eq(t, "text/plain", created.Attrs.ContentType)
+			must.Eq(t, []metastore.Chunk{chunk}, created.Chunks)
+
+			current, err := bucket.Object("foo")
+			must.NoError(t, err)
+			must.Eq(t, created.Generation, current.Generation)
+
+			// Finishing again returns the same object rather than creating
+			// another generation, which the condition would reject anyway.
+			again, err := store.FinishUpload(upload.ID, metastore.FinishUploadOptions{Size: 5})
+			must.NoError(t, err)
+			must.Eq(t, created.Generation, again.Generation)
+
+			finished, err := store.Upload(upload.ID)
+			must.NoError(t, err)
+			must.NotNil(t, finished.Finished)
+			must.Eq(t, created.Generation, finished.Finished.Generation)
+			must.SliceEmpty(t, finished.Chunks)
+
+			_, err = store.AppendUpload(upload.ID, metastore.AppendUploadOptions{Offset: 5, Chunks: []metastore.Chunk{chunk}})
+			must.ErrorIs(t, err, metastore.ErrConflict)
+
+			// The condition is checked when the upload is finished.
+			other, err := store.CreateUpload(metastore.NewUploadOptions{
+				Bucket:     "test-bucket",
+				Object:     "foo",
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.NoError(t, err)
+			var preconditionErr *metastore.PreconditionError
+			_, err = store.FinishUpload(other.ID, metastore.FinishUploadOptions{})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			_, err = store.FinishUpload("missing", metastore.FinishUploadOptions{})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestMultipartUploads(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			_, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload, err := store.CreateUpload(metastore.NewUploadOptions{Bucket: "test-bucket", Object: "foo", Multipart: true})
+			must.NoError(t, err)
+			must.True(t, upload.Multipart)
+
+			chunk := metastore.Chunk{Hash: sha256.Sum256([]byte("phony")), Size: 5}
+			_, err = store.AppendUpload(upload.ID, metastore.AppendUploadOptions{Offset: 0, Chunks: []metastore.Chunk{chunk}})
+			must.ErrorIs(t, err, metastore.ErrConflict)
+
+			for _, number := range []int{3, 1, 2} {
+				_, err = store.PutUploadPart(upload.ID, metastore.UploadPart{Number: number, Chunks: []metastore.Chunk{chunk}, Size: 5})
+				must.NoError(t, err)
+			}
+
+			// Parts with the same number are replaced.
+			other := metastore.Chunk{Hash: sha256.Sum256([]byte("other")), Size: 3}
+			got, err := store.PutUploadPart(upload.ID, metastore.UploadPart{Number: 2, Chunks: []metastore.Chunk{other}, Size: 3})
+			must.NoError(t, err)
+			must.SliceLen(t, 3, got.Parts)
+			for i, part := range got.Parts {
+				must.Eq(t, i+1, part.Number)
+			}
+			must.Eq(t, []metastore.Chunk{other}, got.Parts[1].Chunks)
+
+			var refs []chunkstore.ChunkHash
+			err = store.WalkChunkRefs(func(hash chunkstore.ChunkHash) error {
+				refs = append(refs, hash)
+				return nil
+			})
+			must.NoError(t, err)
+			must.SliceContains(t, refs, other.Hash)
+
+			resumable, err := store.CreateUpload(metastore.NewUploadOptions{Bucket: "test-bucket", Object: "foo"})
+			must.NoError(t, err)
+			_, err = store.PutUploadPart(resumable.ID, metastore.UploadPart{Number: 1})
+			must.ErrorIs(t, err, metastore.ErrConflict)
+		})
+	}
+}
+
+func TestCompleteUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload, err := store.CreateUpload(metastore.NewUploadOptions{Bucket: "test-bucket", Object: "foo", Multipart: true})
+			must.NoError(t, err)
+
+			big := metastore.Chunk{Hash: sha256.Sum256([]byte("big")), Size: metastore.MinUploadPartSize}
+			small := metastore.Chunk{Hash: sha256.Sum256([]byte("small")), Size: 5}
+			parts := []metastore.UploadPart{
+				{Number: 1, Chunks: []metastore.Chunk{big}, MD5Sum: md5.Sum([]byte("1")), Size: big.Size},
+				{Number: 2, Chunks: []metastore.Chunk{small}, MD5Sum: md5.Sum([]byte("2")), Size: small.Size},
+				{Number: 3, Chunks: []metastore.Chunk{small}, MD5Sum: md5.Sum([]byte("3")), Size: small.Size},
+			}
+			for _, part := range parts {
+				_, err = store.PutUploadPart(upload.ID, part)
+				must.NoError(t, err)
+			}
+
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{})
+			must.ErrorIs(t, err, metastore.ErrInvalidPart)
+
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 2, MD5Sum: parts[1].MD5Sum},
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+			}})
+			must.ErrorIs(t, err, metastore.ErrInvalidPartOrder)
+
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+				{Number: 4, MD5Sum: parts[2].MD5Sum},
+			}})
+			must.ErrorIs(t, err, metastore.ErrInvalidPart)
+
+			// Only the last part may be smaller than the minimum.
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+				{Number: 2, MD5Sum: parts[1].MD5Sum},
+				{Number: 3, MD5Sum: parts[2].MD5Sum},
+			}})
+			must.ErrorIs(t, err, metastore.ErrPartTooSmall)
+
+			// Parts replaced since they were listed no longer match.
+			replaced := parts[1]
+			replaced.MD5Sum = md5.Sum([]byte("replaced"))
+			_, err = store.PutUploadPart(upload.ID, replaced)
+			must.NoError(t, err)
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+				{Number: 2, MD5Sum: parts[1].MD5Sum},
+			}})
+			must.ErrorIs(t, err, metastore.ErrInvalidPart)
+
+			created, err := store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+				{Number: 2, MD5Sum: replaced.MD5Sum},
+			}})
+			must.NoError(t, err)
+			must.Eq(t, big.Size+small.Size, created.Size)
+			must.Eq(t, 2, created.ComponentCount)
+			must.Eq(t, []metastore.Chunk{big, small}, created.Chunks)
+
+			current, err := bucket.Object("foo")
+			must.NoError(t, err)
+			must.Eq(t, created.Generation, current.Generation)
+
+			_, err = store.Upload(upload.ID)
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = store.CompleteUpload(upload.ID, metastore.CompleteUploadOptions{Parts: []metastore.CompletedPart{
+				{Number: 1, MD5Sum: parts[0].MD5Sum},
+			}})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			for _, name := range []string{"a", "b/", "b/1", "b/2", "b/c/3", "c", "d/4", "deleted"} {
+				_, err = bucket.PutObject(name, metastore.PutObjectOptions{})
+				must.NoError(t, err)
+			}
+			err = bucket.DeleteObject("deleted", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			names := func(list *metastore.ObjectList) []string {
+				var names []string
+				for _, object := range list.Objects {
+					names = append(names, object.Name)
+				}
+				return names
+			}
+
+			list, err := bucket.ListObjects(metastore.ListObjectsOptions{})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a", "b/", "b/1", "b/2", "b/c/3", "c", "d/4"}, names(list))
+			must.Eq(t, "", list.NextCursor)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Delimiter: "/"})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a", "c"}, names(list))
+			must.Eq(t, []string{"b/", "d/"}, list.Prefixes)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Delimiter: "/", IncludeTrailingDelimiter: true})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a", "b/", "c"}, names(list))
+			must.Eq(t, []string{"b/", "d/"}, list.Prefixes)
+
+			// Objects included for a prefix count towards MaxResults, and are
+			// kept on the same page as their prefix.
+			var pages [][]string
+			options := metastore.ListObjectsOptions{Delimiter: "/", IncludeTrailingDelimiter: true, MaxResults: 2}
+			for {
+				list, err = bucket.ListObjects(options)
+				must.NoError(t, err)
+				pages = append(pages, append(names(list), list.Prefixes...))
+				if list.NextCursor == "" {
+					break
+				}
+				options.Cursor = list.NextCursor
+			}
+			must.Eq(t, [][]string{{"a"}, {"b/", "b/"}, {"c", "d/"}}, pages)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Prefix: "b/", Delimiter: "/"})
+			must.NoError(t, err)
+			must.Eq(t, []string{"b/", "b/1", "b/2"}, names(list))
+			must.Eq(t, []string{"b/c/"}, list.Prefixes)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{StartOffset: "b/2", EndOffset: "d"})
+			must.NoError(t, err)
+			must.Eq(t, []string{"b/2", "b/c/3", "c"}, names(list))
+
+			var paged []string
+			var prefixes []string
+			var cursor string
+			for {
+				list, err = bucket.ListObjects(metastore.ListObjectsOptions{
+					Delimiter:  "/",
+					Cursor:     cursor,
+					MaxResults: 1,
+				})
+				must.NoError(t, err)
+				must.LessEq(t, 1, len(list.Objects)+len(list.Prefixes))
+				paged = append(paged, names(list)...)
+				prefixes = append(prefixes, list.Prefixes...)
+
+				cursor = list.NextCursor
+				if cursor == "" {
+					break
+				}
+			}
+			must.Eq(t, []string{"a", "c"}, paged)
+			must.Eq(t, []string{"b/", "d/"}, prefixes)
+		})
+	}
+}
+
+func TestListBuckets(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			for _, name := range []string{"a-1", "a-2", "a-3", "b-1"} {
+				_, err := store.CreateBucket(name, metastore.NewBucketOptions{Project: "first"})
+				must.NoError(t, err)
+			}
+			_, err := store.CreateBucket("a-other", metastore.NewBucketOptions{Project: "second"})
+			must.NoError(t, err)
+
+			names := func(list *metastore.BucketList) []string {
+				var names []string
+				for _, bucket := range list.Buckets {
+					names = append(names, bucket.Name)
+				}
+				return names
+			}
+
+			list, err := store.ListBuckets(metastore.ListBucketsOptions{Project: "first"})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a-1", "a-2", "a-3", "b-1"}, names(list))
+			must.Eq(t, "first", list.Buckets[0].Project)
+
+			list, err = store.ListBuckets(metastore.ListBucketsOptions{Project: "first", Prefix: "a-", MaxResults: 2})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a-1", "a-2"}, names(list))
+			must.NotEq(t, "", list.NextCursor)
+
+			list, err = store.ListBuckets(metastore.ListBucketsOptions{Project: "first", Prefix: "a-", MaxResults: 2, Cursor: list.NextCursor})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a-3"}, names(list))
+			must.Eq(t, "", list.NextCursor)
+
+			list, err = store.ListBuckets(metastore.ListBucketsOptions{Project: "second"})
+			must.NoError(t, err)
+			must.Eq(t, []string{"a-other"}, names(list))
+		})
+	}
+}
+
+func TestPreconditions(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			zero := int64(0)
+			created, err := bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.NoError(t, err)
+
+			var preconditionErr *metastore.PreconditionError
+			_, err = bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+			must.Eq(t, "ifGenerationMatch", preconditionErr.Condition)
+
+			_, err = bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationNotMatch: &created.Generation},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+			must.Eq(t, "ifGenerationNotMatch", preconditionErr.Condition)
+
+			stale := created.Metageneration + 1
+			_, err = bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfMetagenerationMatch: &stale},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+			must.Eq(t, "ifMetagenerationMatch", preconditionErr.Condition)
+
+			_, err = bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfMetagenerationNotMatch: &created.Metageneration},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+			must.Eq(t, "ifMetagenerationNotMatch", preconditionErr.Condition)
+
+			updated, err := bucket.PutObject("lock", metastore.PutObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationMatch: &created.Generation},
+			})
+			must.NoError(t, err)
+
+			err = bucket.DeleteObject("lock", metastore.DeleteObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationMatch: &created.Generation},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			err = bucket.DeleteObject("lock", metastore.DeleteObjectOptions{
+				Conditions: metastore.Conditions{IfGenerationMatch: &updated.Generation},
+			})
+			must.NoError(t, err)
+		})
+	}
+}
+
+func TestVersioning(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			enabled := true
+			metadata, err := bucket.Update(metastore.UpdateBucketOptions{Versioning: &enabled})
+			must.NoError(t, err)
+			must.True(t, metadata.Versioning)
+			must.Eq(t, 2, metadata.Metageneration)
+
+			first, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 1})
+			must.NoError(t, err)
+			second, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 2})
+			must.NoError(t, err)
+
+			old, err := bucket.ObjectVersion("foo", first.Generation)
+			must.NoError(t, err)
+			must.Eq(t, 1, old.Size)
+			must.False(t, old.DeletedAt.IsZero())
+
+			_, err = bucket.ObjectVersion("foo", 1)
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			_, err = bucket.Object("foo")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			list, err := bucket.ListObjects(metastore.ListObjectsOptions{})
+			must.NoError(t, err)
+			must.SliceEmpty(t, list.Objects)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Versions: true})
+			must.NoError(t, err)
+			must.SliceLen(t, 2, list.Objects)
+			must.Eq(t, first.Generation, list.Objects[0].Generation)
+			must.Eq(t, second.Generation, list.Objects[1].Generation)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Versions: true, MaxResults: 1})
+			must.NoError(t, err)
+			must.SliceLen(t, 1, list.Objects)
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{Versions: true, MaxResults: 1, Cursor: list.NextCursor})
+			must.NoError(t, err)
+			must.SliceLen(t, 1, list.Objects)
+			must.Eq(t, second.Generation, list.Objects[0].Generation)
+			must.Eq(t, "", list.NextCursor)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{Generation: first.Generation})
+			must.NoError(t, err)
+
+			_, err = bucket.ObjectVersion("foo", first.Generation)
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{Generation: second.Generation})
+			must.NoError(t, err)
+
+			err = store.DeleteBucket("test-bucket")
+			must.NoError(t, err)
+		})
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{
+				SoftDeleteRetention: time.Hour,
+			})
+			must.NoError(t, err)
+
+			metadata, err := bucket.Metadata()
+			must.NoError(t, err)
+			must.Eq(t, time.Hour, metadata.SoftDeleteRetention)
+
+			first, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 1})
+			must.NoError(t, err)
+			second, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 2})
+			must.NoError(t, err)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			_, err = bucket.Object("foo")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			list, err := bucket.ListObjects(metastore.ListObjectsOptions{Versions: true})
+			must.NoError(t, err)
+			must.SliceEmpty(t, list.Objects)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{SoftDeleted: true})
+			must.NoError(t, err)
+			must.SliceLen(t, 2, list.Objects)
+			must.Eq(t, first.Generation, list.Objects[0].Generation)
+			must.Eq(t, second.Generation, list.Objects[1].Generation)
+			must.False(t, list.Objects[1].SoftDeletedAt.IsZero())
+			must.True(t, list.Objects[1].SoftDeletedAt.Add(time.Hour).Equal(list.Objects[1].HardDeleteAt))
+
+			_, err = bucket.RestoreObject("foo", metastore.RestoreObjectOptions{Generation: 1})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			restored, err := bucket.RestoreObject("foo", metastore.RestoreObjectOptions{Generation: first.Generation})
+			must.NoError(t, err)
+			must.Eq(t, 1, restored.Size)
+			must.NotEq(t, first.Generation, restored.Generation)
+			must.True(t, restored.SoftDeletedAt.IsZero())
+
+			object, err := bucket.Object("foo")
+			must.NoError(t, err)
+			must.Eq(t, restored.Generation, object.Generation)
+
+			zero := int64(0)
+			_, err = bucket.RestoreObject("foo", metastore.RestoreObjectOptions{
+				Generation: second.Generation,
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			var preconditionErr *metastore.PreconditionError
+			must.True(t, errors.As(err, &preconditionErr))
+
+			// Restoring over the live version soft-deletes it in turn.
+			_, err = bucket.RestoreObject("foo", metastore.RestoreObjectOptions{Generation: second.Generation})
+			must.NoError(t, err)
+
+			list, err = bucket.ListObjects(metastore.ListObjectsOptions{SoftDeleted: true})
+			must.NoError(t, err)
+			must.SliceLen(t, 1, list.Objects)
+			must.Eq(t, restored.Generation, list.Objects[0].Generation)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			// Soft-deleted objects don't keep the bucket from being deleted.
+			err = store.DeleteBucket("test-bucket")
+			must.NoError(t, err)
+		})
+	}
+}
+
+func TestListSoftDeletedPages(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			enabled := true
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{
+				SoftDeleteRetention: time.Hour,
+			})
+			must.NoError(t, err)
+			_, err = bucket.Update(metastore.UpdateBucketOptions{Versioning: &enabled})
+			must.NoError(t, err)
+
+			bar, err := bucket.PutObject("bar", metastore.PutObjectOptions{Size: 1})
+			must.NoError(t, err)
+			err = bucket.DeleteObject("bar", metastore.DeleteObjectOptions{Generation: bar.Generation})
+			must.NoError(t, err)
+
+			var generations []int64
+			for range 3 {
+				object, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 1})
+				must.NoError(t, err)
+				generations = append(generations, object.Generation)
+			}
+
+			// Soft delete the generations out of order.
+			for _, i := range []int{1, 0, 2} {
+				err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{Generation: generations[i]})
+				must.NoError(t, err)
+			}
+
+			type version struct {
+				Name       string
+				Generation int64
+			}
+			var listed []version
+			// Repeated entries would otherwise page forever.
+			options := metastore.ListObjectsOptions{SoftDeleted: true, MaxResults: 1}
+			for range 10 {
+				list, err := bucket.ListObjects(options)
+				must.NoError(t, err)
+				must.SliceLen(t, 1, list.Objects)
+				listed = append(listed, version{list.Objects[0].Name, list.Objects[0].Generation})
+
+				if list.NextCursor == "" {
+					break
+				}
+				options.Cursor = list.NextCursor
+			}
+
+			must.Eq(t, []version{
+				{"bar", bar.Generation},
+				{"foo", generations[0]},
+				{"foo", generations[1]},
+				{"foo", generations[2]},
+			}, listed)
+		})
+	}
+}
+
+func TestSoftDeleteExpiry(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{
+				SoftDeleteRetention: time.Millisecond,
+			})
+			must.NoError(t, err)
+
+			object, err := bucket.PutObject("foo", metastore.PutObjectOptions{Size: 1})
+			must.NoError(t, err)
+
+			err = bucket.DeleteObject("foo", metastore.DeleteObjectOptions{})
+			must.NoError(t, err)
+
+			time.Sleep(10 * time.Millisecond)
+
+			list, err := bucket.ListObjects(metastore.ListObjectsOptions{SoftDeleted: true})
+			must.NoError(t, err)
+			must.SliceEmpty(t, list.Objects)
+
+			_, err = bucket.RestoreObject("foo", metastore.RestoreObjectOptions{Generation: object.Generation})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestUpdateObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			object, err := bucket.PutObject("foo", metastore.PutObjectOptions{
+				Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte("foo")), Size: 3}},
+				Size:   3,
+				Attrs: metastore.ObjectAttrs{
+					ContentType: "text/plain",
+					Metadata:    map[string]string{"a": "1", "b": "2"},
+				},
+			})
+			must.NoError(t, err)
+			must.Eq(t, "text/plain", object.Attrs.ContentType)
+
+			cacheControl := "no-cache"
+			value := "3"
+			updated, err := bucket.UpdateObject("foo", metastore.UpdateObjectOptions{
+				CacheControl: &cacheControl,
+				Metadata:     map[string]*string{"a": nil, "c": &value},
+			})
+			must.NoError(t, err)
+			must.Eq(t, object.Generation, updated.Generation)
+			must.Eq(t, 2, updated.Metageneration)
+			must.Eq(t, object.Chunks, updated.Chunks)
+			must.Eq(t, metastore.ObjectAttrs{
+				ContentType:  "text/plain",
+				CacheControl: "no-cache",
+				Metadata:     map[string]string{"b": "2", "c": "3"},
+			}, updated.Attrs)
+
+			stale := int64(1)
+			_, err = bucket.UpdateObject("foo", metastore.UpdateObjectOptions{
+				ReplaceMetadata: true,
+				Conditions:      metastore.Conditions{IfMetagenerationMatch: &stale},
+			})
+			var preconditionErr *metastore.PreconditionError
+			must.True(t, errors.As(err, &preconditionErr))
+
+			updated, err = bucket.UpdateObject("foo", metastore.UpdateObjectOptions{ReplaceMetadata: true})
+			must.NoError(t, err)
+			must.Eq(t, 3, updated.Metageneration)
+			must.MapEmpty(t, updated.Attrs.Metadata)
+
+			_, err = bucket.UpdateObject("bar", metastore.UpdateObjectOptions{})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestComposeObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			bucket, err := store.CreateBucket("test-bucket", metastore.NewBucketOptions{Versioning: true})
+			must.NoError(t, err)
+
+			put := func(name, contents string) *metastore.Object {
+				object, err := bucket.PutObject(name, metastore.PutObjectOptions{
+					Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte(contents)), Size: int64(len(contents))}},
+					MD5Sum: md5.Sum([]byte(contents)),
+					Size:   int64(len(contents)),
+				})
+				must.NoError(t, err)
+				return object
+			}
+
+			a := put("a", "aaa")
+			oldB := put("b", "b")
+			b := put("b", "bb")
+
+			composed, err := bucket.ComposeObject("c", metastore.ComposeObjectOptions{
+				Sources: []metastore.ComposeSource{
+					{Name: "a", Conditions: metastore.Conditions{IfGenerationMatch: &a.Generation}},
+					{Name: "b", Generation: oldB.Generation},
+				},
+				Attrs: metastore.ObjectAttrs{ContentType: "text/plain"},
+			})
+			must.NoError(t, err)
+			must.Eq(t, 4, composed.Size)
+			must.Eq(t, 2, composed.ComponentCount)
+			must.Eq(t, append(a.Chunks, oldB.Chunks...), composed.Chunks)
+			must.Eq(t, [md5.Size]byte{}, composed.MD5Sum)
+			must.Eq(t, "text/plain", composed.Attrs.ContentType)
+
+			composed, err = bucket.ComposeObject("d", metastore.ComposeObjectOptions{
+				Sources: []metastore.ComposeSource{{Name: "c"}, {Name: "b"}},
+			})
+			must.NoError(t, err)
+			must.Eq(t, 6, composed.Size)
+			must.Eq(t, 3, composed.ComponentCount)
+
+			var preconditionErr *metastore.PreconditionError
+			_, err = bucket.ComposeObject("e", metastore.ComposeObjectOptions{
+				Sources: []metastore.ComposeSource{{Name: "b", Conditions: metastore.Conditions{IfGenerationMatch: &oldB.Generation}}},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			zero := int64(0)
+			_, err = bucket.ComposeObject("d", metastore.ComposeObjectOptions{
+				Sources:    []metastore.ComposeSource{{Name: "b", Generation: b.Generation}},
+				Conditions: metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			_, err = bucket.ComposeObject("e", metastore.ComposeObjectOptions{
+				Sources: []metastore.ComposeSource{{Name: "a"}, {Name: "missing"}},
+			})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = bucket.Object("e")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = bucket.ComposeObject("e", metastore.ComposeObjectOptions{
+				Sources: make([]metastore.ComposeSource, metastore.MaxComposeSources+1),
+			})
+			must.Error(t, err)
+		})
+	}
+}
+
+func TestCopyObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := tc.store(t)
+
+			src, err := store.CreateBucket("src-bucket", metastore.NewBucketOptions{Versioning: true})
+			must.NoError(t, err)
+			dest, err := store.CreateBucket("dest-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			put := func(contents string) *metastore.Object {
+				object, err := src.PutObject("a", metastore.PutObjectOptions{
+					Chunks: []metastore.Chunk{{Hash: sha256.Sum256([]byte(contents)), Size: int64(len(contents))}},
+					MD5Sum: md5.Sum([]byte(contents)),
+					Size:   int64(len(contents)),
+					Attrs:  metastore.ObjectAttrs{ContentType: "text/plain", Metadata: map[string]string{"key": contents}},
+				})
+				must.NoError(t, err)
+				return object
+			}
+
+			old := put("old")
+			live := put("live")
+
+			copied, err := dest.CopyObject("b", metastore.CopyObjectOptions{
+				SourceBucket:     "src-bucket",
+				SourceName:       "a",
+				SourceGeneration: old.Generation,
+				SourceConditions: metastore.Conditions{IfGenerationMatch: &old.Generation},
+			})
+			must.NoError(t, err)
+			must.Eq(t, "b", copied.Name)
+			must.Eq(t, old.Chunks, copied.Chunks)
+			must.Eq(t, old.MD5Sum, copied.MD5Sum)
+			must.Eq(t, old.Size, copied.Size)
+			must.Eq(t, old.Attrs, copied.Attrs)
+			must.NotEq(t, old.Generation, copied.Generation)
+			must.Eq(t, 1, copied.Metageneration)
+
+			copied, err = dest.CopyObject("b", metastore.CopyObjectOptions{
+				SourceBucket: "src-bucket",
+				SourceName:   "a",
+				Attrs:        &metastore.ObjectAttrs{ContentType: "application/json"},
+				Conditions:   metastore.Conditions{IfGenerationMatch: &copied.Generation},
+			})
+			must.NoError(t, err)
+			must.Eq(t, live.Chunks, copied.Chunks)
+			must.Eq(t, metastore.ObjectAttrs{ContentType: "application/json"}, copied.Attrs)
+
+			object, err := dest.Object("b")
+			must.NoError(t, err)
+			must.Eq(t, copied, object)
+
+			var preconditionErr *metastore.PreconditionError
+			_, err = dest.CopyObject("c", metastore.CopyObjectOptions{
+				SourceBucket:     "src-bucket",
+				SourceName:       "a",
+				SourceConditions: metastore.Conditions{IfGenerationMatch: &old.Generation},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			zero := int64(0)
+			_, err = dest.CopyObject("b", metastore.CopyObjectOptions{
+				SourceBucket: "src-bucket",
+				SourceName:   "a",
+				Conditions:   metastore.Conditions{IfGenerationMatch: &zero},
+			})
+			must.True(t, errors.As(err, &preconditionErr))
+
+			_, err = dest.CopyObject("c", metastore.CopyObjectOptions{SourceBucket: "src-bucket", SourceName: "missing"})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = dest.CopyObject("c", metastore.CopyObjectOptions{SourceBucket: "missing", SourceName: "a"})
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			_, err = dest.Object("c")
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+		})
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	for prefix, end := range map[string]string{
+		"":          "",
+		"a/":        "a0",
+		"foo":       "fop",
+		"a\xff":     "b",
+		"\xff\xff":  "",
+		"photos/2x": "photos/2y",
+	} {
+		must.Eq(t, end, metastore.PrefixEnd(prefix), must.Sprint(prefix))
+	}
+}
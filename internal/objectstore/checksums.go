@@ -0,0 +1,42 @@
+package objectstore
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// ChecksumError is returned when an object's contents don't match a checksum
+// supplied by the client.
+type ChecksumError struct {
+	// Checksum is the kind of checksum which didn't match, either "md5" or
+	// "crc32c".
+	Checksum string
+	// Provided and Calculated are the big-endian checksums.
+	Provided   []byte
+	Calculated []byte
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("%s mismatch: provided %x, calculated %x", e.Checksum, e.Provided, e.Calculated)
+}
+
+func verifyChecksums(expected metastore.ExpectedChecksums, md5Sum chunkstore.MD5Hash, crc uint32) error {
+	if expected.MD5 != nil && *expected.MD5 != md5Sum {
+		return &ChecksumError{
+			Checksum:   "md5",
+			Provided:   expected.MD5[:],
+			Calculated: md5Sum[:],
+		}
+	}
+	if expected.CRC32C != nil && *expected.CRC32C != crc {
+		return &ChecksumError{
+			Checksum:   "crc32c",
+			Provided:   binary.BigEndian.AppendUint32(nil, *expected.CRC32C),
+			Calculated: binary.BigEndian.AppendUint32(nil, crc),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+)
+
+// CollectGarbage deletes chunks which are no longer referenced by any object
+// version or in-progress upload, returning how many were deleted.
+//
+// Chunks are written before the metastore references them, so chunks written
+// less than grace before the collection started are always kept. The grace
+// period must be longer than it takes a writer to reference its chunk.
+func (s *Store) CollectGarbage(grace time.Duration) (int, error) {
+	cutoff := time.Now().Add(-grace)
+
+	// Mark before sweeping: anything referenced after this point is either in
+	// the set already or was written after the cutoff.
+	referenced := make(map[chunkstore.ChunkHash]struct{})
+	err := s.metaStore.WalkChunkRefs(func(hash chunkstore.ChunkHash) error {
+		referenced[hash] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mark chunks: %w", err)
+	}
+
+	var deleted int
+	err = s.chunkStore.Walk(func(hash chunkstore.ChunkHash, modTime time.Time) error {
+		if _, ok := referenced[hash]; ok || modTime.After(cutoff) {
+			return nil
+		}
+
+		// A writer may have rewritten the chunk since it was walked, and be
+		// about to reference it, so its age is checked again as it's deleted.
+		ok, err := s.chunkStore.DeleteIfOlder(hash, cutoff)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("delete chunk: %w", err)
+		}
+		if ok {
+			deleted++
+		}
+		return nil
+	})
+	if err != nil {
+		return deleted, fmt.Errorf("sweep chunks: %w", err)
+	}
+
+	return deleted, nil
+}
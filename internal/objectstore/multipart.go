@@ -0,0 +1,147 @@
+package objectstore
+
+import (
+	"io"
+	"slices"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// NewMultipartUpload starts a multipart upload which will create this object
+// with the given attributes once completed. Like resumable uploads, its state
+// is kept in the metastore.
+func (o *Object) NewMultipartUpload(attrs metastore.ObjectAttrs) (*MultipartUpload, error) {
+	metadata, err := o.metaStore.CreateUpload(metastore.NewUploadOptions{
+		Bucket:    o.bucket,
+		Object:    o.name,
+		Attrs:     attrs,
+		Multipart: true,
+
+		Conditions: o.conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultipartUpload{
+		metaStore: o.metaStore,
+		chunker:   o.chunker,
+		metadata:  metadata,
+	}, nil
+}
+
+// MultipartUpload looks up an in-progress multipart upload by its id.
+func (s *Store) MultipartUpload(id string) (*MultipartUpload, error) {
+	metadata, err := s.metaStore.Upload(id)
+	if err != nil {
+		return nil, err
+	}
+	if !metadata.Multipart {
+		return nil, metastore.ErrNotExist
+	}
+
+	return &MultipartUpload{
+		metaStore: s.metaStore,
+		chunker:   s.chunker,
+		metadata:  metadata,
+	}, nil
+}
+
+type MultipartUpload struct {
+	metaStore metastore.Store
+	chunker   *chunker.Chunker
+	metadata  *metastore.Upload
+}
+
+func (u *MultipartUpload) ID() string {
+	return u.metadata.ID
+}
+
+func (u *MultipartUpload) Bucket() string {
+	return u.metadata.Bucket
+}
+
+func (u *MultipartUpload) Object() string {
+	return u.metadata.Object
+}
+
+func (u *MultipartUpload) Metadata() *metastore.Upload {
+	return u.metadata
+}
+
+// PutPart stores everything read from r as the numbered part, replacing any
+// part previously uploaded with that number. The part is only stored if its
+// contents match checksums.
+func (u *MultipartUpload) PutPart(number int, r io.Reader, checksums metastore.ExpectedChecksums) (*metastore.UploadPart, error) {
+	writer := u.chunker.NewWriter()
+
+	_, err := io.Copy(writer, r)
+	if err != nil {
+		writer.Abort()
+		return nil, err
+	}
+
+	chunks, sums, err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyChecksums(checksums, sums.MD5, sums.CRC32C)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	for _, chunk := range chunks {
+		size += chunk.Size
+	}
+
+	metadata, err := u.metaStore.PutUploadPart(u.metadata.ID, metastore.UploadPart{
+		Number: number,
+		Chunks: chunks,
+		MD5Sum: sums.MD5,
+		CRC32C: sums.CRC32C,
+		Size:   size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.metadata = metadata
+
+	i, _ := slices.BinarySearchFunc(metadata.Parts, number, func(p metastore.UploadPart, number int) int {
+		return p.Number - number
+	})
+	return &metadata.Parts[i], nil
+}
+
+// CompletedPart identifies an uploaded part to include in the object.
+type CompletedPart struct {
+	Number int
+	MD5Sum chunkstore.MD5Hash
+}
+
+// Complete creates the object by concatenating the chunks of the given parts,
+// which must be in ascending order, and removes the upload. Every part but the
+// last must be at least metastore.MinUploadPartSize. Like composite objects,
+// the object has no MD5 hash.
+func (u *MultipartUpload) Complete(parts []CompletedPart) (*metastore.Object, error) {
+	completed := make([]metastore.CompletedPart, len(parts))
+	for i, part := range parts {
+		completed[i] = metastore.CompletedPart{Number: part.Number, MD5Sum: part.MD5Sum}
+	}
+
+	// The parts are checked against those stored in the same transaction
+	// which creates the object, so they can't be replaced meanwhile.
+	return u.metaStore.CompleteUpload(u.metadata.ID, metastore.CompleteUploadOptions{
+		Parts: completed,
+	})
+}
+
+// Abort abandons the upload. Chunks which are no longer referenced are
+// removed by garbage collection.
+func (u *MultipartUpload) Abort() error {
+	return u.metaStore.DeleteUpload(u.metadata.ID)
+}
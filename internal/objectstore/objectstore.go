@@ -1,11 +1,9 @@
 package objectstore
 
 import (
-	"errors"
-	"io"
-	"os"
-	"sync/atomic"
+	"fmt"
 
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
 	"github.com/cbrewster/gcs-emulator/internal/metastore"
 )
@@ -13,10 +11,42 @@ import (
 type Store struct {
 	metaStore  metastore.Store
 	chunkStore chunkstore.Store
+	chunker    *chunker.Chunker
+	prefetch   int
+}
+
+type Options struct {
+	// Chunking controls how the contents of objects are split into chunks.
+	Chunking chunker.Options
+	// Prefetch is the number of upcoming chunks an ObjectReader reads
+	// concurrently while reading sequentially. Zero disables prefetching.
+	Prefetch int
+}
+
+var DefaultOptions = Options{
+	Chunking: chunker.DefaultOptions,
+	Prefetch: 4,
 }
 
 func New(metaStore metastore.Store, chunkStore chunkstore.Store) *Store {
-	return &Store{metaStore, chunkStore}
+	return NewWithOptions(metaStore, chunkStore, DefaultOptions)
+}
+
+// NewWithOptions is like New but allows configuring how objects are chunked
+// and read. The chunking options must be valid.
+func NewWithOptions(metaStore metastore.Store, chunkStore chunkstore.Store, options Options) *Store {
+	return &Store{
+		metaStore:  metaStore,
+		chunkStore: chunkStore,
+		chunker:    chunker.New(chunkStore, options.Chunking),
+		prefetch:   options.Prefetch,
+	}
+}
+
+// ChunkStats returns statistics about the chunks written so far, including how
+// well they deduplicated.
+func (s *Store) ChunkStats() chunker.Stats {
+	return s.chunker.Stats()
 }
 
 func (s *Store) Bucket(name string) (*Bucket, error) {
@@ -26,81 +56,210 @@ func (s *Store) Bucket(name string) (*Bucket, error) {
 	}
 
 	return &Bucket{
+		metaStore:  s.metaStore,
 		metaBucket: metaBucket,
 		chunkStore: s.chunkStore,
+		chunker:    s.chunker,
+		prefetch:   s.prefetch,
 		name:       name,
 	}, nil
 }
 
-func (s *Store) CreateBucket(name string) (*Bucket, error) {
-	metaBucket, err := s.metaStore.CreateBucket(name, metastore.NewBucketOptions{})
+func (s *Store) CreateBucket(name string, options metastore.NewBucketOptions) (*Bucket, error) {
+	metaBucket, err := s.metaStore.CreateBucket(name, options)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Bucket{
+		metaStore:  s.metaStore,
 		metaBucket: metaBucket,
 		chunkStore: s.chunkStore,
+		chunker:    s.chunker,
+		prefetch:   s.prefetch,
 		name:       name,
 	}, nil
 }
 
+func (s *Store) DeleteBucket(name string) error {
+	return s.metaStore.DeleteBucket(name)
+}
+
+func (s *Store) ListBuckets(options metastore.ListBucketsOptions) (*metastore.BucketList, error) {
+	return s.metaStore.ListBuckets(options)
+}
+
 type Bucket struct {
+	metaStore  metastore.Store
 	metaBucket metastore.Bucket
 	chunkStore chunkstore.Store
+	chunker    *chunker.Chunker
+	prefetch   int
 	name       string
 }
 
+func (b *Bucket) Name() string {
+	return b.name
+}
+
+func (b *Bucket) Metadata() (*metastore.BucketMetadata, error) {
+	return b.metaBucket.Metadata()
+}
+
+func (b *Bucket) Update(options metastore.UpdateBucketOptions) (*metastore.BucketMetadata, error) {
+	return b.metaBucket.Update(options)
+}
+
+func (b *Bucket) ListObjects(options metastore.ListObjectsOptions) (*metastore.ObjectList, error) {
+	return b.metaBucket.ListObjects(options)
+}
+
 func (b *Bucket) Object(name string) *Object {
 	return &Object{
+		metaStore:  b.metaStore,
 		metaBucket: b.metaBucket,
 		chunkStore: b.chunkStore,
+		chunker:    b.chunker,
+		prefetch:   b.prefetch,
+		bucket:     b.name,
 		name:       name,
 	}
 }
 
 type Object struct {
+	metaStore  metastore.Store
 	metaBucket metastore.Bucket
 	chunkStore chunkstore.Store
+	chunker    *chunker.Chunker
+	prefetch   int
+	bucket     string
 	name       string
+	generation int64
+	conditions metastore.Conditions
 }
 
-func (o *Object) NewWriter() (*ObjectWriter, error) {
-	writer, err := o.chunkStore.NewWriter()
+func (o *Object) Name() string {
+	return o.name
+}
+
+// If returns a copy of the object handle where all operations are subject to
+// the given preconditions.
+func (o *Object) If(conditions metastore.Conditions) *Object {
+	object := *o
+	object.conditions = conditions
+	return &object
+}
+
+// Generation returns a copy of the object handle which refers to a specific
+// generation of the object rather than the live version. Writes are not
+// affected.
+func (o *Object) Generation(generation int64) *Object {
+	object := *o
+	object.generation = generation
+	return &object
+}
+
+func (o *Object) Metadata() (*metastore.Object, error) {
+	var metadata *metastore.Object
+	var err error
+	if o.generation != 0 {
+		metadata, err = o.metaBucket.ObjectVersion(o.name, o.generation)
+	} else {
+		metadata, err = o.metaBucket.Object(o.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.conditions.Check(metadata.Generation, metadata.Metageneration)
 	if err != nil {
 		return nil, err
 	}
 
+	return metadata, nil
+}
+
+func (o *Object) Delete() error {
+	return o.metaBucket.DeleteObject(o.name, metastore.DeleteObjectOptions{
+		Generation: o.generation,
+		Conditions: o.conditions,
+	})
+}
+
+// Update changes the attributes of the object without touching its contents.
+// The generation and conditions of the handle take precedence over those in
+// options.
+func (o *Object) Update(options metastore.UpdateObjectOptions) (*metastore.Object, error) {
+	options.Generation = o.generation
+	options.Conditions = o.conditions
+	return o.metaBucket.UpdateObject(o.name, options)
+}
+
+// Restore makes the soft-deleted generation of the object live again. The
+// object's conditions are checked against its current live version.
+func (o *Object) Restore() (*metastore.Object, error) {
+	return o.metaBucket.RestoreObject(o.name, metastore.RestoreObjectOptions{
+		Generation: o.generation,
+		Conditions: o.conditions,
+	})
+}
+
+// NewWriter returns a writer which creates the object once closed. The data
+// is split into chunks as configured by the store's chunking options, and
+// chunks which are already stored aren't stored again.
+func (o *Object) NewWriter() (*ObjectWriter, error) {
 	return &ObjectWriter{
 		object: o,
-		writer: writer,
+		writer: o.chunker.NewWriter(),
 	}, nil
 }
 
 type ObjectWriter struct {
+	// Attrs are the attributes the object is created with. They must be set
+	// before the writer is closed.
+	Attrs metastore.ObjectAttrs
+	// Checksums are verified against the written contents before the object
+	// is created.
+	Checksums metastore.ExpectedChecksums
+
 	object   *Object
-	writer   chunkstore.ChunkWriter
+	writer   *chunker.Writer
+	size     int64
 	metadata *metastore.Object
 }
 
 // Write implements io.WriteCloser.
 func (w *ObjectWriter) Write(p []byte) (n int, err error) {
-	return w.writer.Write(p)
+	n, err = w.writer.Write(p)
+	w.size += int64(n)
+	return n, err
 }
 
 // Close implements io.WriteCloser.
 func (w *ObjectWriter) Close() error {
-	chunkHash, md5Hash, err := w.writer.Close()
+	chunks, checksums, err := w.writer.Close()
+	if err != nil {
+		return err
+	}
+
+	// On a mismatch the chunks are left for the garbage collector.
+	err = verifyChecksums(w.Checksums, checksums.MD5, checksums.CRC32C)
 	if err != nil {
 		return err
 	}
 
 	metadata, err := w.object.metaBucket.PutObject(w.object.name, metastore.PutObjectOptions{
-		Chunks: []chunkstore.ChunkHash{chunkHash},
-		MD5Sum: md5Hash,
+		Chunks: chunks,
+		MD5Sum: checksums.MD5,
+		CRC32C: checksums.CRC32C,
+		Size:   w.size,
+		Attrs:  w.Attrs,
+
+		Conditions: w.object.conditions,
 	})
 	if err != nil {
-		// TODO: Not safe to delete chunk since it may be shared.
+		// The chunks may be shared with other objects, so they're left for the
+		// garbage collector.
 		return err
 	}
 
@@ -109,120 +268,85 @@ func (w *ObjectWriter) Close() error {
 	return nil
 }
 
+// Abort discards the data written so far without creating the object. It does
+// nothing if the writer has already been closed.
+func (w *ObjectWriter) Abort() error {
+	return w.writer.Abort()
+}
+
 // TODO: We shouldn't leak metastore outside, probably need _another_ set of types?
 func (w *ObjectWriter) Metadata() *metastore.Object {
 	return w.metadata
 }
 
-func (o *Object) NewReader() (*ObjectReader, error) {
-	metadata, err := o.metaBucket.Object(o.name)
-	if err != nil {
-		return nil, err
+// CopyFrom returns a Copier which copies src, which may be in another bucket,
+// to this object. Chunks are shared rather than copied.
+func (o *Object) CopyFrom(src *Object) *Copier {
+	return &Copier{
+		dest: o,
+		src:  src,
 	}
-
-	return &ObjectReader{
-		object:   o,
-		metadata: metadata,
-		queue:    metadata.Chunks,
-	}, nil
-}
-
-type ObjectReader struct {
-	object   *Object
-	metadata *metastore.Object
-	queue    []chunkstore.ChunkHash
-	current  io.ReadSeekCloser
-	closed   atomic.Bool
 }
 
-var _ io.ReadCloser = (*ObjectReader)(nil)
-
-func (r *ObjectReader) next() error {
-	if len(r.queue) == 0 {
-		return io.EOF
-	}
-
-	var first chunkstore.ChunkHash
-	first, r.queue = r.queue[0], r.queue[1:]
+type Copier struct {
+	// Attrs, if set, are the attributes the copy is created with instead of
+	// those of the source.
+	Attrs *metastore.ObjectAttrs
 
-	var err error
-	r.current, err = r.object.chunkStore.NewReader(first)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	dest *Object
+	src  *Object
 }
 
-// Read implements io.ReadCloser.
-func (r *ObjectReader) Read(p []byte) (int, error) {
-	if r.closed.Load() {
-		return 0, os.ErrClosed
-	}
-
-	if r.current == nil {
-		err := r.next()
-		if err != nil {
-			return 0, err
-		}
-	}
-
-	// TODO: Could read in a loop to move to next chunk, but meh this is fine for now.
-	n, err := r.current.Read(p)
-	if errors.Is(err, io.EOF) {
-		err := r.current.Close()
-		if err != nil {
-			return n, err
-		}
-		r.current = nil
-		return n, nil
-	}
-
-	return n, err
-}
-
-// Close implements io.ReadCloser.
-func (r *ObjectReader) Close() error {
-	if r.closed.Swap(true) {
-		return os.ErrClosed
-	}
-
-	if r.current == nil {
-		return nil
-	}
-
-	return r.current.Close()
+// Run copies the source in a single metastore transaction, so the copy can't
+// reference chunks of a source which was deleted and collected meanwhile.
+func (c *Copier) Run() (*metastore.Object, error) {
+	return c.dest.metaBucket.CopyObject(c.dest.name, metastore.CopyObjectOptions{
+		SourceBucket:     c.src.bucket,
+		SourceName:       c.src.name,
+		SourceGeneration: c.src.generation,
+		SourceConditions: c.src.conditions,
+		Attrs:            c.Attrs,
+
+		Conditions: c.dest.conditions,
+	})
 }
 
+// ComposeFrom returns a Composer which concatenates objects, which must be in
+// the same bucket, into this object. The generation and conditions of each
+// source handle select and check the version of the source which is used.
 func (o *Object) ComposeFrom(objects ...*Object) *Composer {
 	return &Composer{
-		metaBucket: o.metaBucket,
-		dest:       o,
-		from:       objects,
+		dest: o,
+		from: objects,
 	}
 }
 
 type Composer struct {
-	metaBucket metastore.Bucket
-	dest       *Object
-	from       []*Object
+	// Attrs are the attributes the composite object is created with.
+	Attrs metastore.ObjectAttrs
+
+	dest *Object
+	from []*Object
 }
 
-func (c *Composer) Run() error {
-	var chunks []chunkstore.ChunkHash
+func (c *Composer) Run() (*metastore.Object, error) {
+	sources := make([]metastore.ComposeSource, len(c.from))
+	for i, object := range c.from {
+		if object.bucket != c.dest.bucket {
+			return nil, fmt.Errorf("compose %s/%s: source %s/%s is in a different bucket", c.dest.bucket, c.dest.name, object.bucket, object.name)
+		}
 
-	// TODO: Maybe this should be moved down to the meta layer and done in a transaction?
-	for _, object := range c.from {
-		meta, err := c.metaBucket.Object(object.name)
-		if err != nil {
-			return err
+		sources[i] = metastore.ComposeSource{
+			Name:       object.name,
+			Generation: object.generation,
+			Conditions: object.conditions,
 		}
-		chunks = append(chunks, meta.Chunks...)
 	}
 
-	_, err := c.metaBucket.PutObject(c.dest.name, metastore.PutObjectOptions{
-		Chunks: chunks,
-		MD5Sum: chunkstore.MD5Hash{}, // Composite objects do not have an md5sum
+	return c.dest.metaBucket.ComposeObject(c.dest.name, metastore.ComposeObjectOptions{
+		Sources: sources,
+		Attrs:   c.Attrs,
+
+		Conditions: c.dest.conditions,
 	})
-	return err
 }
@@ -1,20 +1,31 @@
 package objectstore_test
 
 import (
+	"bytes"
+	"crypto/md5"
 	"crypto/rand"
 	"crypto/sha256"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/shoenig/test/must"
 
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
 	"github.com/cbrewster/gcs-emulator/internal/chunkstore/file"
+	chunkmemory "github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
 	"github.com/cbrewster/gcs-emulator/internal/metastore"
 	"github.com/cbrewster/gcs-emulator/internal/metastore/bolt"
+	metamemory "github.com/cbrewster/gcs-emulator/internal/metastore/memory"
 	"github.com/cbrewster/gcs-emulator/internal/objectstore"
 )
 
@@ -44,6 +55,14 @@ func newBoltStore(t *testing.T) metastore.Store {
 	return store
 }
 
+func newChunkMemoryStore(t *testing.T) chunkstore.Store {
+	return chunkmemory.New()
+}
+
+func newMetaMemoryStore(t *testing.T) metastore.Store {
+	return metamemory.New()
+}
+
 var testCases = []struct {
 	name       string
 	metaStore  func(t *testing.T) metastore.Store
@@ -52,6 +71,10 @@ var testCases = []struct {
 	name:       "bolt+file",
 	metaStore:  newBoltStore,
 	chunkStore: newFileStore,
+}, {
+	name:       "memory+memory",
+	metaStore:  newMetaMemoryStore,
+	chunkStore: newChunkMemoryStore,
 }}
 
 func TestWriteReadObject(t *testing.T) {
@@ -59,7 +82,7 @@ func TestWriteReadObject(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
 
-			bucket, err := store.CreateBucket("my-bucket")
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
 			must.NoError(t, err)
 
 			data, err := io.ReadAll(io.LimitReader(rand.Reader, 1024))
@@ -79,7 +102,7 @@ func TestWriteReadObject(t *testing.T) {
 
 			expectedHash := sha256.Sum256(data)
 			metadata := w.Metadata()
-			must.Eq(t, expectedHash, metadata.Chunks[0])
+			must.Eq(t, expectedHash, metadata.Chunks[0].Hash)
 
 			r, err := object.NewReader()
 			must.NoError(t, err)
@@ -97,7 +120,7 @@ func TestComposeObjects(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
 
-			bucket, err := store.CreateBucket("my-bucket")
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
 			must.NoError(t, err)
 
 			var compositeData []byte
@@ -124,8 +147,11 @@ func TestComposeObjects(t *testing.T) {
 
 			object := bucket.Object("composed")
 
-			err = object.ComposeFrom(objects...).Run()
+			composed, err := object.ComposeFrom(objects...).Run()
 			must.NoError(t, err)
+			must.Eq(t, 3, composed.ComponentCount)
+			must.Eq(t, 30, composed.Size)
+			must.Eq(t, crc32c.Checksum(compositeData), composed.CRC32C)
 
 			r, err := object.NewReader()
 			must.NoError(t, err)
@@ -137,3 +163,506 @@ func TestComposeObjects(t *testing.T) {
 		})
 	}
 }
+
+func TestSeekReadAtObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			var objects []*objectstore.Object
+			for i, data := range []string{"abc", "", "defg", "hi"} {
+				object := bucket.Object(strconv.Itoa(i))
+
+				w, err := object.NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = w.Write([]byte(data))
+				must.NoError(t, err)
+
+				err = w.Close()
+				must.NoError(t, err)
+
+				objects = append(objects, object)
+			}
+
+			object := bucket.Object("composed")
+			_, err = object.ComposeFrom(objects...).Run()
+			must.NoError(t, err)
+
+			r, err := object.NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			size, err := r.Seek(0, io.SeekEnd)
+			must.NoError(t, err)
+			must.Eq(t, 9, size)
+
+			_, err = r.Seek(2, io.SeekStart)
+			must.NoError(t, err)
+			read, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "cdefghi", string(read))
+
+			_, err = r.Seek(-3, io.SeekEnd)
+			must.NoError(t, err)
+			buf := make([]byte, 2)
+			_, err = io.ReadFull(r, buf)
+			must.NoError(t, err)
+			must.Eq(t, "gh", string(buf))
+
+			buf = make([]byte, 5)
+			n, err := r.ReadAt(buf, 1)
+			must.NoError(t, err)
+			must.Eq(t, "bcdef", string(buf[:n]))
+
+			n, err = r.ReadAt(buf, 6)
+			must.ErrorIs(t, err, io.EOF)
+			must.Eq(t, "ghi", string(buf[:n]))
+		})
+	}
+}
+
+func TestResumableUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metaStore, chunkStore := tc.metaStore(t), tc.chunkStore(t)
+			store := objectstore.New(metaStore, chunkStore)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload, err := bucket.Object("cool").NewUpload(metastore.ObjectAttrs{}, metastore.ExpectedChecksums{})
+			must.NoError(t, err)
+
+			_, err = upload.Append(strings.NewReader("hello "))
+			must.NoError(t, err)
+
+			// Simulate a restart by resuming the upload from a fresh store.
+			store = objectstore.New(metaStore, chunkStore)
+			upload, err = store.Upload(upload.ID())
+			must.NoError(t, err)
+			must.Eq(t, 6, upload.Size())
+
+			_, err = upload.Append(strings.NewReader("world"))
+			must.NoError(t, err)
+
+			wrong := md5.Sum([]byte("hello"))
+			_, err = upload.Finish(metastore.ExpectedChecksums{MD5: &wrong})
+			var checksumErr *objectstore.ChecksumError
+			must.True(t, errors.As(err, &checksumErr))
+			must.Eq(t, "md5", checksumErr.Checksum)
+
+			crc := crc32c.Checksum([]byte("hello world"))
+			metadata, err := upload.Finish(metastore.ExpectedChecksums{CRC32C: &crc})
+			must.NoError(t, err)
+			must.Eq(t, 11, metadata.Size)
+			must.Eq(t, md5.Sum([]byte("hello world")), metadata.MD5Sum)
+			must.Eq(t, crc, metadata.CRC32C)
+			must.SliceLen(t, 2, metadata.Chunks)
+
+			// A retry returns the same object, even from a fresh handle.
+			upload, err = store.Upload(upload.ID())
+			must.NoError(t, err)
+			must.NotNil(t, upload.Finished())
+			must.Eq(t, metadata.Generation, upload.Finished().Generation)
+			again, err := upload.Finish(metastore.ExpectedChecksums{})
+			must.NoError(t, err)
+			must.Eq(t, metadata.Generation, again.Generation)
+
+			r, err := bucket.Object("cool").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			read, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(read))
+		})
+	}
+}
+
+func TestMultipartUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metaStore, chunkStore := tc.metaStore(t), tc.chunkStore(t)
+			store := objectstore.New(metaStore, chunkStore)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload, err := bucket.Object("cool").NewMultipartUpload(metastore.ObjectAttrs{ContentType: "text/plain"})
+			must.NoError(t, err)
+
+			// Every part but the last must be at least the minimum size.
+			hello := strings.Repeat("hello ", metastore.MinUploadPartSize/6+1)
+
+			// Parts may be uploaded in any order, and replaced.
+			for number, data := range map[int]string{2: "world", 1: "goodbye ", 3: "!"} {
+				_, err = upload.PutPart(number, strings.NewReader(data), metastore.ExpectedChecksums{})
+				must.NoError(t, err)
+			}
+
+			wrong := md5.Sum([]byte("hello"))
+			_, err = upload.PutPart(1, strings.NewReader(hello), metastore.ExpectedChecksums{MD5: &wrong})
+			var checksumErr *objectstore.ChecksumError
+			must.True(t, errors.As(err, &checksumErr))
+
+			part, err := upload.PutPart(1, strings.NewReader(hello), metastore.ExpectedChecksums{})
+			must.NoError(t, err)
+			must.Eq(t, md5.Sum([]byte(hello)), part.MD5Sum)
+
+			// Resumable and multipart uploads can't be mixed up.
+			_, err = store.Upload(upload.ID())
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			// Simulate a restart by resuming the upload from a fresh store.
+			store = objectstore.New(metaStore, chunkStore)
+			upload, err = store.MultipartUpload(upload.ID())
+			must.NoError(t, err)
+			must.SliceLen(t, 3, upload.Metadata().Parts)
+
+			_, err = upload.Complete([]objectstore.CompletedPart{
+				{Number: 2, MD5Sum: md5.Sum([]byte("world"))},
+				{Number: 1, MD5Sum: md5.Sum([]byte(hello))},
+			})
+			must.ErrorIs(t, err, metastore.ErrInvalidPartOrder)
+
+			_, err = upload.Complete([]objectstore.CompletedPart{
+				{Number: 1, MD5Sum: md5.Sum([]byte("goodbye "))},
+				{Number: 2, MD5Sum: md5.Sum([]byte("world"))},
+			})
+			must.ErrorIs(t, err, metastore.ErrInvalidPart)
+
+			_, err = upload.Complete([]objectstore.CompletedPart{
+				{Number: 2, MD5Sum: md5.Sum([]byte("world"))},
+				{Number: 3, MD5Sum: md5.Sum([]byte("!"))},
+			})
+			must.ErrorIs(t, err, metastore.ErrPartTooSmall)
+
+			metadata, err := upload.Complete([]objectstore.CompletedPart{
+				{Number: 1, MD5Sum: md5.Sum([]byte(hello))},
+				{Number: 2, MD5Sum: md5.Sum([]byte("world"))},
+			})
+			must.NoError(t, err)
+			must.Eq(t, int64(len(hello))+5, metadata.Size)
+			must.Eq(t, 2, metadata.ComponentCount)
+			must.Eq(t, crc32c.Checksum([]byte(hello+"world")), metadata.CRC32C)
+			must.Eq(t, "text/plain", metadata.Attrs.ContentType)
+
+			// The object shares the parts' chunks.
+			must.Eq(t, slices.Concat(upload.Metadata().Parts[0].Chunks, upload.Metadata().Parts[1].Chunks), metadata.Chunks)
+
+			_, err = store.MultipartUpload(upload.ID())
+			must.ErrorIs(t, err, metastore.ErrNotExist)
+
+			r, err := bucket.Object("cool").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			read, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, hello+"world", string(read))
+		})
+	}
+}
+
+func TestCollectGarbage(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunkStore := tc.chunkStore(t)
+			store := objectstore.New(tc.metaStore(t), chunkStore)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			write := func(name, contents string) chunkstore.ChunkHash {
+				w, err := bucket.Object(name).NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = io.WriteString(w, contents)
+				must.NoError(t, err)
+
+				err = w.Close()
+				must.NoError(t, err)
+
+				return w.Metadata().Chunks[0].Hash
+			}
+
+			overwritten := write("foo", "old")
+			live := write("foo", "new")
+			copied := write("bar", "copied")
+			_, err = bucket.Object("baz").CopyFrom(bucket.Object("bar")).Run()
+			must.NoError(t, err)
+			err = bucket.Object("bar").Delete()
+			must.NoError(t, err)
+
+			upload, err := bucket.Object("qux").NewUpload(metastore.ObjectAttrs{}, metastore.ExpectedChecksums{})
+			must.NoError(t, err)
+			_, err = upload.Append(strings.NewReader("uploading"))
+			must.NoError(t, err)
+			uploading := sha256.Sum256([]byte("uploading"))
+
+			// Everything is within the grace period.
+			deleted, err := store.CollectGarbage(time.Hour)
+			must.NoError(t, err)
+			must.Eq(t, 0, deleted)
+
+			deleted, err = store.CollectGarbage(0)
+			must.NoError(t, err)
+			must.Eq(t, 1, deleted)
+
+			_, err = chunkStore.NewReader(overwritten)
+			must.ErrorIs(t, err, os.ErrNotExist)
+			for _, hash := range []chunkstore.ChunkHash{live, copied, uploading} {
+				r, err := chunkStore.NewReader(hash)
+				must.NoError(t, err)
+				r.Close()
+			}
+
+			err = upload.Cancel()
+			must.NoError(t, err)
+
+			deleted, err = store.CollectGarbage(0)
+			must.NoError(t, err)
+			must.Eq(t, 1, deleted)
+		})
+	}
+}
+
+// sweepHookStore calls hook as the garbage collector reaches each chunk, after
+// the chunk's modification time was read but before it's deleted.
+type sweepHookStore struct {
+	chunkstore.Store
+	hook func(hash chunkstore.ChunkHash)
+}
+
+func (s sweepHookStore) Walk(fn func(hash chunkstore.ChunkHash, modTime time.Time) error) error {
+	return s.Store.Walk(func(hash chunkstore.ChunkHash, modTime time.Time) error {
+		s.hook(hash)
+		return fn(hash, modTime)
+	})
+}
+
+func TestCollectGarbageRefreshedChunk(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunkStore := &sweepHookStore{Store: tc.chunkStore(t)}
+			store := objectstore.New(tc.metaStore(t), chunkStore)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			write := func(name string) {
+				w, err := bucket.Object(name).NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = io.WriteString(w, "contents")
+				must.NoError(t, err)
+
+				err = w.Close()
+				must.NoError(t, err)
+			}
+
+			write("foo")
+			err = bucket.Object("foo").Delete()
+			must.NoError(t, err)
+
+			// Modification times are coarse, so leave a margin either side of
+			// the cutoff.
+			time.Sleep(50 * time.Millisecond)
+
+			// Between marking and sweeping, another object deduplicates
+			// against the unreferenced chunk.
+			rewritten := false
+			chunkStore.hook = func(chunkstore.ChunkHash) {
+				if !rewritten {
+					rewritten = true
+					write("bar")
+				}
+			}
+
+			deleted, err := store.CollectGarbage(25 * time.Millisecond)
+			must.NoError(t, err)
+			must.Eq(t, 0, deleted)
+			must.True(t, rewritten)
+
+			r, err := bucket.Object("bar").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, "contents", string(data))
+		})
+	}
+}
+
+func TestDeduplicateVersions(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{Versioning: true})
+			must.NoError(t, err)
+
+			data, err := io.ReadAll(io.LimitReader(rand.Reader, 16<<20))
+			must.NoError(t, err)
+
+			write := func(data []byte) *metastore.Object {
+				w, err := bucket.Object("artifact").NewWriter()
+				must.NoError(t, err)
+				defer w.Close()
+
+				_, err = w.Write(data)
+				must.NoError(t, err)
+
+				err = w.Close()
+				must.NoError(t, err)
+
+				return w.Metadata()
+			}
+
+			first := write(data)
+			must.Greater(t, 1, len(first.Chunks))
+
+			data[len(data)/2] ^= 0xff
+			second := write(data)
+
+			stats := store.ChunkStats()
+			must.Eq(t, 32<<20, stats.Bytes)
+			must.Greater(t, int64(len(second.Chunks)-2), stats.DuplicateChunks)
+			must.Greater(t, 1.5, stats.DedupRatio())
+
+			r, err := bucket.Object("artifact").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			read, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.True(t, bytes.Equal(data, read))
+		})
+	}
+}
+
+func TestFixedSizeChunks(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.NewWithOptions(tc.metaStore(t), tc.chunkStore(t), objectstore.Options{
+				Chunking: chunker.FixedSize(1000),
+				Prefetch: 2,
+			})
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			data, err := io.ReadAll(io.LimitReader(rand.Reader, 10500))
+			must.NoError(t, err)
+
+			object := bucket.Object("fixed")
+
+			w, err := object.NewWriter()
+			must.NoError(t, err)
+			defer w.Close()
+
+			_, err = w.Write(data)
+			must.NoError(t, err)
+
+			err = w.Close()
+			must.NoError(t, err)
+
+			chunks := w.Metadata().Chunks
+			must.SliceLen(t, 11, chunks)
+			must.Eq(t, 1000, chunks[0].Size)
+			must.Eq(t, 500, chunks[10].Size)
+
+			r, err := object.NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			read, err := io.ReadAll(r)
+			must.NoError(t, err)
+			must.Eq(t, data, read)
+
+			// Seeking backwards and forwards within and across chunks.
+			for _, offset := range []int64{2500, 999, 10499, 0, 7000} {
+				_, err = r.Seek(offset, io.SeekStart)
+				must.NoError(t, err)
+
+				buf := make([]byte, 1500)
+				n, err := io.ReadFull(r, buf)
+				if offset+1500 > 10500 {
+					must.ErrorIs(t, err, io.ErrUnexpectedEOF)
+				} else {
+					must.NoError(t, err)
+				}
+				must.Eq(t, data[offset:offset+int64(n)], buf[:n])
+			}
+
+			buf := make([]byte, 2000)
+			n, err := r.ReadAt(buf, 4321)
+			must.NoError(t, err)
+			must.Eq(t, data[4321:4321+n], buf[:n])
+		})
+	}
+}
+
+// countingStore counts how many times chunks are opened for reading.
+type countingStore struct {
+	chunkstore.Store
+	reads atomic.Int64
+}
+
+func (s *countingStore) NewReader(hash chunkstore.ChunkHash) (io.ReadSeekCloser, error) {
+	s.reads.Add(1)
+	return s.Store.NewReader(hash)
+}
+
+func TestPrefetchReadLimit(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chunkStore := &countingStore{Store: tc.chunkStore(t)}
+			store := objectstore.NewWithOptions(tc.metaStore(t), chunkStore, objectstore.Options{
+				Chunking: chunker.FixedSize(1000),
+				Prefetch: 4,
+			})
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			data, err := io.ReadAll(io.LimitReader(rand.Reader, 10000))
+			must.NoError(t, err)
+
+			w, err := bucket.Object("fixed").NewWriter()
+			must.NoError(t, err)
+			defer w.Close()
+			_, err = w.Write(data)
+			must.NoError(t, err)
+			must.NoError(t, w.Close())
+
+			// Writing checks existing chunks, only reads are counted.
+			chunkStore.reads.Store(0)
+
+			r, err := bucket.Object("fixed").NewReader()
+			must.NoError(t, err)
+			defer r.Close()
+
+			// Reading [1500, 2500) only needs the second and third chunks.
+			_, err = r.Seek(1500, io.SeekStart)
+			must.NoError(t, err)
+			r.SetReadLimit(2500)
+
+			buf := make([]byte, 1000)
+			_, err = io.ReadFull(r, buf)
+			must.NoError(t, err)
+			must.Eq(t, data[1500:2500], buf)
+
+			// Give any prefetches past the limit a chance to start.
+			time.Sleep(10 * time.Millisecond)
+			must.Eq(t, 2, chunkStore.reads.Load())
+		})
+	}
+}
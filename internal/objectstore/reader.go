@@ -0,0 +1,314 @@
+package objectstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync/atomic"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+func (o *Object) NewReader() (*ObjectReader, error) {
+	metadata, err := o.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return newObjectReader(o, metadata), nil
+}
+
+func newObjectReader(o *Object, metadata *metastore.Object) *ObjectReader {
+	offsets := make([]int64, len(metadata.Chunks))
+	var offset int64
+	for i, chunk := range metadata.Chunks {
+		offsets[i] = offset
+		offset += chunk.Size
+	}
+
+	return &ObjectReader{
+		object:     o,
+		metadata:   metadata,
+		offsets:    offsets,
+		limit:      metadata.Size,
+		prefetched: make(map[int]*prefetchedChunk),
+	}
+}
+
+type ObjectReader struct {
+	object   *Object
+	metadata *metastore.Object
+	// offsets holds the offset within the object at which each chunk starts.
+	offsets []int64
+	// offset is the position of the next Read within the object.
+	offset int64
+	// limit is where sequential reads are expected to stop, chunks after it
+	// aren't prefetched.
+	limit int64
+
+	current       io.ReadSeekCloser
+	currentIndex  int
+	currentOffset int64
+
+	// prefetched holds the upcoming chunks being read in the background,
+	// keyed by index.
+	prefetched map[int]*prefetchedChunk
+
+	closed atomic.Bool
+}
+
+var (
+	_ io.ReadSeekCloser = (*ObjectReader)(nil)
+	_ io.ReaderAt       = (*ObjectReader)(nil)
+)
+
+func (r *ObjectReader) Metadata() *metastore.Object {
+	return r.metadata
+}
+
+// SetReadLimit tells the reader that Read won't be called past end, so chunks
+// which start after it aren't prefetched. Reads past end still work, they
+// just aren't prefetched.
+func (r *ObjectReader) SetReadLimit(end int64) {
+	r.limit = min(end, r.metadata.Size)
+}
+
+// chunkAt returns the index of the chunk containing the byte at offset.
+func (r *ObjectReader) chunkAt(offset int64) int {
+	return sort.Search(len(r.offsets), func(i int) bool {
+		return r.offsets[i]+r.metadata.Chunks[i].Size > offset
+	})
+}
+
+// openChunk opens the chunk at index positioned at offset within the object.
+func (r *ObjectReader) openChunk(index int, offset int64) (io.ReadSeekCloser, error) {
+	reader, err := r.object.chunkStore.NewReader(r.metadata.Chunks[index].Hash)
+	if err != nil {
+		return nil, fmt.Errorf("open chunk: %w", err)
+	}
+
+	if offset != r.offsets[index] {
+		_, err = reader.Seek(offset-r.offsets[index], io.SeekStart)
+		if err != nil {
+			reader.Close()
+			return nil, fmt.Errorf("seek chunk: %w", err)
+		}
+	}
+
+	return reader, nil
+}
+
+// prefetchedChunk is the contents of a chunk read in the background.
+type prefetchedChunk struct {
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+func (p *prefetchedChunk) fetch(store chunkstore.Store, chunk metastore.Chunk) {
+	defer close(p.done)
+
+	reader, err := store.NewReader(chunk.Hash)
+	if err != nil {
+		p.err = fmt.Errorf("open chunk: %w", err)
+		return
+	}
+	defer reader.Close()
+
+	p.data = make([]byte, chunk.Size)
+	_, err = io.ReadFull(reader, p.data)
+	if err != nil {
+		p.err = fmt.Errorf("read chunk: %w", err)
+	}
+}
+
+type bytesReadSeekCloser struct {
+	*bytes.Reader
+}
+
+func (bytesReadSeekCloser) Close() error {
+	return nil
+}
+
+// prefetch starts reading the chunks following index, up to the read limit,
+// in the background and forgets about any prefetched chunks outside of that
+// window.
+func (r *ObjectReader) prefetch(index int) {
+	last := min(index+r.object.prefetch, len(r.metadata.Chunks)-1)
+	if r.limit < r.metadata.Size {
+		last = min(last, r.chunkAt(r.limit-1))
+	}
+	for i := range r.prefetched {
+		if i <= index || i > last {
+			delete(r.prefetched, i)
+		}
+	}
+
+	for i := index + 1; i <= last; i++ {
+		if _, ok := r.prefetched[i]; ok {
+			continue
+		}
+
+		p := &prefetchedChunk{done: make(chan struct{})}
+		r.prefetched[i] = p
+		go p.fetch(r.object.chunkStore, r.metadata.Chunks[i])
+	}
+}
+
+// openNextChunk opens the chunk at index for Read, using its prefetched
+// contents if available, and prefetches the chunks after it.
+func (r *ObjectReader) openNextChunk(index int, offset int64) (io.ReadSeekCloser, error) {
+	p, ok := r.prefetched[index]
+	r.prefetch(index)
+	if !ok {
+		return r.openChunk(index, offset)
+	}
+
+	<-p.done
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	reader := bytesReadSeekCloser{bytes.NewReader(p.data)}
+	_, err := reader.Seek(offset-r.offsets[index], io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("seek chunk: %w", err)
+	}
+	return reader, nil
+}
+
+// Read implements io.Reader. Chunks after the one being read are prefetched
+// concurrently.
+func (r *ObjectReader) Read(p []byte) (int, error) {
+	if r.closed.Load() {
+		return 0, os.ErrClosed
+	}
+
+	if r.offset >= r.metadata.Size {
+		return 0, io.EOF
+	}
+
+	index := r.chunkAt(r.offset)
+	if r.current != nil && (r.currentIndex != index || r.currentOffset != r.offset) {
+		err := r.current.Close()
+		r.current = nil
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if r.current == nil {
+		var err error
+		r.current, err = r.openNextChunk(index, r.offset)
+		if err != nil {
+			return 0, err
+		}
+		r.currentIndex = index
+		r.currentOffset = r.offset
+	}
+
+	chunkEnd := r.offsets[index] + r.metadata.Chunks[index].Size
+	if remaining := chunkEnd - r.offset; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := r.current.Read(p)
+	r.offset += int64(n)
+	r.currentOffset += int64(n)
+	if errors.Is(err, io.EOF) {
+		if n == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		err = nil
+	}
+
+	return n, err
+}
+
+// Seek implements io.Seeker.
+func (r *ObjectReader) Seek(offset int64, whence int) (int64, error) {
+	if r.closed.Load() {
+		return 0, os.ErrClosed
+	}
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.metadata.Size
+	default:
+		return 0, errors.New("seek: invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("seek: negative position")
+	}
+
+	r.offset = offset
+	return offset, nil
+}
+
+// ReadAt implements io.ReaderAt. It does not affect the offset used by Read
+// and is safe to call concurrently.
+func (r *ObjectReader) ReadAt(p []byte, off int64) (int, error) {
+	if r.closed.Load() {
+		return 0, os.ErrClosed
+	}
+
+	if off < 0 {
+		return 0, errors.New("read at: negative offset")
+	}
+
+	var read int
+	for read < len(p) {
+		if off >= r.metadata.Size {
+			return read, io.EOF
+		}
+
+		index := r.chunkAt(off)
+		reader, err := r.openChunk(index, off)
+		if err != nil {
+			return read, err
+		}
+
+		buf := p[read:]
+		chunkEnd := r.offsets[index] + r.metadata.Chunks[index].Size
+		if remaining := chunkEnd - off; int64(len(buf)) > remaining {
+			buf = buf[:remaining]
+		}
+
+		n, err := io.ReadFull(reader, buf)
+		reader.Close()
+		read += n
+		off += int64(n)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return read, err
+		}
+	}
+
+	return read, nil
+}
+
+// Close implements io.Closer.
+func (r *ObjectReader) Close() error {
+	if r.closed.Swap(true) {
+		return os.ErrClosed
+	}
+
+	// In-flight prefetches finish in the background and are dropped.
+	clear(r.prefetched)
+
+	if r.current == nil {
+		return nil
+	}
+
+	return r.current.Close()
+}
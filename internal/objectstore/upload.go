@@ -0,0 +1,186 @@
+package objectstore
+
+import (
+	"crypto/md5"
+	"encoding"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/crc32c"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// NewUpload starts a resumable upload which will create this object with the
+// given attributes once finished, provided its contents match checksums. The
+// upload's state is kept in the metastore so it survives restarts.
+func (o *Object) NewUpload(attrs metastore.ObjectAttrs, checksums metastore.ExpectedChecksums) (*Upload, error) {
+	metadata, err := o.metaStore.CreateUpload(metastore.NewUploadOptions{
+		Bucket:    o.bucket,
+		Object:    o.name,
+		Attrs:     attrs,
+		Checksums: checksums,
+
+		Conditions: o.conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Upload{
+		metaStore: o.metaStore,
+		chunker:   o.chunker,
+		metadata:  metadata,
+	}, nil
+}
+
+// Upload looks up a resumable upload by its id. It may already have been
+// finished.
+func (s *Store) Upload(id string) (*Upload, error) {
+	metadata, err := s.metaStore.Upload(id)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Multipart {
+		return nil, metastore.ErrNotExist
+	}
+
+	return &Upload{
+		metaStore: s.metaStore,
+		chunker:   s.chunker,
+		metadata:  metadata,
+	}, nil
+}
+
+type Upload struct {
+	metaStore metastore.Store
+	chunker   *chunker.Chunker
+	metadata  *metastore.Upload
+}
+
+func (u *Upload) ID() string {
+	return u.metadata.ID
+}
+
+func (u *Upload) Bucket() string {
+	return u.metadata.Bucket
+}
+
+func (u *Upload) Object() string {
+	return u.metadata.Object
+}
+
+// Size returns the number of bytes persisted so far.
+func (u *Upload) Size() int64 {
+	return u.metadata.Size
+}
+
+func (u *Upload) md5Hasher() (hash.Hash, error) {
+	hasher := md5.New()
+	if u.metadata.HashState != nil {
+		err := hasher.(encoding.BinaryUnmarshaler).UnmarshalBinary(u.metadata.HashState)
+		if err != nil {
+			return nil, fmt.Errorf("restore md5 state: %w", err)
+		}
+	}
+	return hasher, nil
+}
+
+// Append stores everything read from r as new chunks at the end of the
+// upload. Chunk boundaries are found separately for each append.
+func (u *Upload) Append(r io.Reader) (int64, error) {
+	hasher, err := u.md5Hasher()
+	if err != nil {
+		return 0, err
+	}
+
+	writer := u.chunker.NewWriter()
+
+	n, err := io.Copy(io.MultiWriter(writer, hasher), r)
+	if err != nil || n == 0 {
+		writer.Abort()
+		return n, err
+	}
+
+	chunks, _, err := writer.Close()
+	if err != nil {
+		return n, err
+	}
+
+	hashState, err := hasher.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return n, fmt.Errorf("save md5 state: %w", err)
+	}
+
+	metadata, err := u.metaStore.AppendUpload(u.metadata.ID, metastore.AppendUploadOptions{
+		Offset:    u.metadata.Size,
+		Chunks:    chunks,
+		HashState: hashState,
+	})
+	if err != nil {
+		return n, err
+	}
+
+	u.metadata = metadata
+
+	return n, nil
+}
+
+// Finish creates the object from the uploaded chunks. Checksums supplied when
+// finishing take precedence over those supplied when the upload was started.
+// Once finished, the upload keeps the object it created, which finishing it
+// again returns.
+func (u *Upload) Finish(checksums metastore.ExpectedChecksums) (*metastore.Object, error) {
+	if u.metadata.Finished != nil {
+		return u.metadata.Finished, nil
+	}
+
+	hasher, err := u.md5Hasher()
+	if err != nil {
+		return nil, err
+	}
+	md5Sum := chunkstore.MD5Hash(hasher.Sum(nil))
+
+	var crc uint32
+	for _, chunk := range u.metadata.Chunks {
+		crc = crc32c.Combine(crc, chunk.CRC32C, chunk.Size)
+	}
+
+	expected := u.metadata.Checksums
+	if checksums.MD5 != nil {
+		expected.MD5 = checksums.MD5
+	}
+	if checksums.CRC32C != nil {
+		expected.CRC32C = checksums.CRC32C
+	}
+	err = verifyChecksums(expected, md5Sum, crc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The object is created and the upload marked finished in one
+	// transaction, so a retry can't create a second generation.
+	metadata, err := u.metaStore.FinishUpload(u.metadata.ID, metastore.FinishUploadOptions{
+		Size:   u.metadata.Size,
+		MD5Sum: md5Sum,
+		CRC32C: crc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// Finished returns the object created by finishing the upload, or nil if it
+// hasn't been finished.
+func (u *Upload) Finished() *metastore.Object {
+	return u.metadata.Finished
+}
+
+// Cancel abandons the upload.
+func (u *Upload) Cancel() error {
+	return u.metaStore.DeleteUpload(u.metadata.ID)
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+type bucketResource struct {
+	Kind             string                  `json:"kind"`
+	ID               string                  `json:"id"`
+	SelfLink         string                  `json:"selfLink"`
+	Name             string                  `json:"name"`
+	ProjectNumber    string                  `json:"projectNumber"`
+	Metageneration   int64                   `json:"metageneration,string"`
+	Location         string                  `json:"location"`
+	LocationType     string                  `json:"locationType"`
+	StorageClass     string                  `json:"storageClass"`
+	ETag             string                  `json:"etag"`
+	TimeCreated      string                  `json:"timeCreated"`
+	Updated          string                  `json:"updated"`
+	Versioning       *bucketVersioning       `json:"versioning,omitempty"`
+	SoftDeletePolicy *bucketSoftDeletePolicy `json:"softDeletePolicy,omitempty"`
+}
+
+type bucketVersioning struct {
+	Enabled bool `json:"enabled"`
+}
+
+type bucketSoftDeletePolicy struct {
+	RetentionDurationSeconds int64  `json:"retentionDurationSeconds,string"`
+	EffectiveTime            string `json:"effectiveTime,omitempty"`
+}
+
+func (p *bucketSoftDeletePolicy) retention() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return time.Duration(p.RetentionDurationSeconds) * time.Second
+}
+
+func newBucketResource(r *http.Request, metadata *metastore.BucketMetadata) *bucketResource {
+	name := metadata.Name
+	return &bucketResource{
+		Kind:           "storage#bucket",
+		ID:             name,
+		SelfLink:       baseURL(r) + "/storage/v1/b/" + name,
+		Name:           name,
+		ProjectNumber:  "0",
+		Metageneration: metadata.Metageneration,
+		Location:       "US",
+		LocationType:   "multi-region",
+		StorageClass:   "STANDARD",
+		ETag:           base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(metadata.Metageneration))),
+		TimeCreated:    formatTime(metadata.CreatedAt),
+		Updated:        formatTime(metadata.UpdatedAt),
+		Versioning:     &bucketVersioning{Enabled: metadata.Versioning},
+		SoftDeletePolicy: &bucketSoftDeletePolicy{
+			RetentionDurationSeconds: int64(metadata.SoftDeleteRetention / time.Second),
+			EffectiveTime:            formatTime(metadata.UpdatedAt),
+		},
+	}
+}
+
+func (s *Server) bucket(name string) (*objectstore.Bucket, error) {
+	bucket, err := s.store.Bucket(name)
+	if errors.Is(err, metastore.ErrNotExist) {
+		return nil, notFound("The specified bucket does not exist.")
+	}
+	return bucket, err
+}
+
+func (s *Server) insertBucket(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name             string                  `json:"name"`
+		Versioning       *bucketVersioning       `json:"versioning"`
+		SoftDeletePolicy *bucketSoftDeletePolicy `json:"softDeletePolicy"`
+	}
+	err := readJSON(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("Bucket name is required."))
+		return
+	}
+
+	bucket, err := s.store.CreateBucket(req.Name, metastore.NewBucketOptions{
+		Project:    r.URL.Query().Get("project"),
+		Versioning: req.Versioning != nil && req.Versioning.Enabled,
+
+		SoftDeleteRetention: req.SoftDeletePolicy.retention(),
+	})
+	if errors.Is(err, metastore.ErrAlreadyExists) {
+		writeError(w, &httpError{
+			code:    http.StatusConflict,
+			reason:  "conflict",
+			message: "Your previous request to create the named bucket succeeded and you already own it.",
+		})
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newBucketResource(r, metadata))
+}
+
+type bucketsResponse struct {
+	Kind          string            `json:"kind"`
+	Items         []*bucketResource `json:"items,omitempty"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	maxResults, err := parseMaxResults(query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	cursor, err := decodePageToken(query.Get("pageToken"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	list, err := s.store.ListBuckets(metastore.ListBucketsOptions{
+		Project:    query.Get("project"),
+		Prefix:     query.Get("prefix"),
+		Cursor:     cursor,
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res := bucketsResponse{
+		Kind:          "storage#buckets",
+		NextPageToken: encodePageToken(list.NextCursor),
+	}
+	for _, metadata := range list.Buckets {
+		res.Items = append(res.Items, newBucketResource(r, metadata))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) getBucket(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := bucket.Metadata()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newBucketResource(r, metadata))
+}
+
+func (s *Server) patchBucket(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req struct {
+		Versioning       *bucketVersioning       `json:"versioning"`
+		SoftDeletePolicy *bucketSoftDeletePolicy `json:"softDeletePolicy"`
+	}
+	err = readJSON(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var options metastore.UpdateBucketOptions
+	if req.Versioning != nil {
+		options.Versioning = &req.Versioning.Enabled
+	}
+	if req.SoftDeletePolicy != nil {
+		retention := req.SoftDeletePolicy.retention()
+		options.SoftDeleteRetention = &retention
+	}
+
+	metadata, err := bucket.Update(options)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newBucketResource(r, metadata))
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	err := s.store.DeleteBucket(r.PathValue("bucket"))
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = notFound("The specified bucket does not exist.")
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"strings"
+
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// encodeCRC32C encodes a CRC32C checksum the way GCS does, as base64 of its
+// big-endian bytes.
+func encodeCRC32C(crc uint32) string {
+	return base64.StdEncoding.EncodeToString(binary.BigEndian.AppendUint32(nil, crc))
+}
+
+// parseChecksums parses base64-encoded checksums supplied by a client. Empty
+// checksums are left unset.
+func parseChecksums(md5Hash, crc32c string) (metastore.ExpectedChecksums, error) {
+	var checksums metastore.ExpectedChecksums
+
+	if md5Hash != "" {
+		decoded, err := base64.StdEncoding.DecodeString(md5Hash)
+		if err != nil || len(decoded) != md5.Size {
+			return metastore.ExpectedChecksums{}, badRequest("Invalid value for md5Hash.")
+		}
+		checksums.MD5 = (*[md5.Size]byte)(decoded)
+	}
+
+	if crc32c != "" {
+		decoded, err := base64.StdEncoding.DecodeString(crc32c)
+		if err != nil || len(decoded) != 4 {
+			return metastore.ExpectedChecksums{}, badRequest("Invalid value for crc32c.")
+		}
+		crc := binary.BigEndian.Uint32(decoded)
+		checksums.CRC32C = &crc
+	}
+
+	return checksums, nil
+}
+
+// parseHashHeader parses the checksums in X-Goog-Hash headers, which look like
+// "crc32c=n03x6A==,md5=Ojk9c3dhfxgoKVVHYwFbHQ==". The header may also be
+// repeated for each checksum.
+func parseHashHeader(header http.Header) (metastore.ExpectedChecksums, error) {
+	var md5Hash, crc32c string
+	for _, value := range header.Values("X-Goog-Hash") {
+		for _, hash := range strings.Split(value, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(hash), "=")
+			switch name {
+			case "md5":
+				md5Hash = value
+			case "crc32c":
+				crc32c = value
+			}
+		}
+	}
+	return parseChecksums(md5Hash, crc32c)
+}
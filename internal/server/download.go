@@ -0,0 +1,184 @@
+package server
+
+import (
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+func (s *Server) downloadObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	reader, err := object.NewReader()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(bucket.Name(), object.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer reader.Close()
+
+	metadata := reader.Metadata()
+
+	setObjectHeaders(w.Header(), metadata)
+	w.Header().Set("ETag", `"`+objectETag(metadata)+`"`)
+
+	serveObject(w, r, reader)
+}
+
+// setObjectHeaders sets the headers describing an object which are common to
+// the JSON and XML APIs.
+func setObjectHeaders(header http.Header, metadata *metastore.Object) {
+	header.Set("Content-Type", contentType(metadata.Attrs))
+	for key, value := range map[string]string{
+		"Content-Encoding":    metadata.Attrs.ContentEncoding,
+		"Content-Disposition": metadata.Attrs.ContentDisposition,
+		"Content-Language":    metadata.Attrs.ContentLanguage,
+		"Cache-Control":       metadata.Attrs.CacheControl,
+	} {
+		if value != "" {
+			header.Set(key, value)
+		}
+	}
+	if !metadata.Attrs.CustomTime.IsZero() {
+		header.Set("X-Goog-Custom-Time", formatTime(metadata.Attrs.CustomTime))
+	}
+	for key, value := range metadata.Attrs.Metadata {
+		header.Set("X-Goog-Meta-"+key, value)
+	}
+	header.Set("X-Goog-Generation", strconv.FormatInt(metadata.Generation, 10))
+	header.Set("X-Goog-Metageneration", strconv.FormatInt(metadata.Metageneration, 10))
+	header.Set("X-Goog-Stored-Content-Length", strconv.FormatInt(metadata.Size, 10))
+	header.Set("X-Goog-Stored-Content-Encoding", storedContentEncoding(metadata.Attrs))
+	header.Set("X-Goog-Storage-Class", "STANDARD")
+	header.Add("X-Goog-Hash", "crc32c="+encodeCRC32C(metadata.CRC32C))
+	if metadata.MD5Sum != (chunkstore.MD5Hash{}) {
+		header.Add("X-Goog-Hash", "md5="+base64.StdEncoding.EncodeToString(metadata.MD5Sum[:]))
+	}
+
+	if isGzip(metadata.Attrs) {
+		header.Add("Vary", "Accept-Encoding")
+	}
+}
+
+// serveObject writes the contents of an object, transcoding gzipped objects
+// if needed.
+func serveObject(w http.ResponseWriter, r *http.Request, reader *objectstore.ObjectReader) {
+	metadata := reader.Metadata()
+
+	if transcode(r, metadata.Attrs) {
+		gz, err := gzip.NewReader(reader)
+		if err == nil {
+			// The decompressed length isn't known up front, and Range
+			// requests are served in full like GCS does.
+			w.Header().Del("Content-Encoding")
+			w.WriteHeader(http.StatusOK)
+
+			_, err = io.Copy(w, gz)
+			if err != nil {
+				log.Printf("transcode %s: %v", metadata.Name, err)
+			}
+			return
+		}
+
+		// Objects which aren't actually gzipped are served as stored.
+		_, err = reader.Seek(0, io.SeekStart)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	// ServeContent takes care of Range, If-Range and conditional headers,
+	// responding with 206 or 416 as appropriate.
+	reader.SetReadLimit(rangeEnd(r, metadata.Size))
+	http.ServeContent(w, r, "", metadata.UpdatedAt, reader)
+}
+
+// rangeEnd returns the end of the byte range requested by r, or size if it
+// may read up to the end of the object. Only a single range with an end is
+// narrowed, since the result just limits prefetching.
+func rangeEnd(r *http.Request, size int64) int64 {
+	spec, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return size
+	}
+
+	first, last, ok := strings.Cut(spec, "-")
+	if !ok || strings.TrimSpace(first) == "" {
+		return size
+	}
+	end, err := strconv.ParseInt(strings.TrimSpace(last), 10, 64)
+	if err != nil || end < 0 {
+		return size
+	}
+	return min(end+1, size)
+}
+
+func isGzip(attrs metastore.ObjectAttrs) bool {
+	return strings.EqualFold(attrs.ContentEncoding, "gzip")
+}
+
+func storedContentEncoding(attrs metastore.ObjectAttrs) string {
+	if attrs.ContentEncoding == "" {
+		return "identity"
+	}
+	return attrs.ContentEncoding
+}
+
+// transcode reports whether a gzipped object should be decompressed before
+// it's served, which GCS calls decompressive transcoding. Clients accepting
+// gzip get the stored bytes, as do all clients if the object's Cache-Control
+// includes no-transform.
+func transcode(r *http.Request, attrs metastore.ObjectAttrs) bool {
+	if !isGzip(attrs) || acceptsGzip(r) {
+		return false
+	}
+
+	for _, directive := range strings.Split(attrs.CacheControl, ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-transform") {
+			return false
+		}
+	}
+	return true
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+				continue
+			}
+
+			// A quality of zero means gzip is not acceptable.
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if value, err := strconv.ParseFloat(q, 64); err == nil && value == 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,732 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+type objectResource struct {
+	Kind               string            `json:"kind"`
+	ID                 string            `json:"id"`
+	SelfLink           string            `json:"selfLink"`
+	MediaLink          string            `json:"mediaLink"`
+	Name               string            `json:"name"`
+	Bucket             string            `json:"bucket"`
+	Generation         int64             `json:"generation,string"`
+	Metageneration     int64             `json:"metageneration,string"`
+	ContentType        string            `json:"contentType"`
+	ContentEncoding    string            `json:"contentEncoding,omitempty"`
+	ContentDisposition string            `json:"contentDisposition,omitempty"`
+	ContentLanguage    string            `json:"contentLanguage,omitempty"`
+	CacheControl       string            `json:"cacheControl,omitempty"`
+	CustomTime         string            `json:"customTime,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	StorageClass       string            `json:"storageClass"`
+	Size               int64             `json:"size,string"`
+	ComponentCount     int64             `json:"componentCount,omitempty"`
+	MD5Hash            string            `json:"md5Hash,omitempty"`
+	CRC32C             string            `json:"crc32c"`
+	ETag               string            `json:"etag"`
+	TimeCreated        string            `json:"timeCreated"`
+	Updated            string            `json:"updated"`
+	TimeDeleted        string            `json:"timeDeleted,omitempty"`
+	SoftDeleteTime     string            `json:"softDeleteTime,omitempty"`
+	HardDeleteTime     string            `json:"hardDeleteTime,omitempty"`
+}
+
+// defaultContentType is reported for objects created without a content type.
+const defaultContentType = "application/octet-stream"
+
+func contentType(attrs metastore.ObjectAttrs) string {
+	if attrs.ContentType == "" {
+		return defaultContentType
+	}
+	return attrs.ContentType
+}
+
+func newObjectResource(r *http.Request, bucket string, metadata *metastore.Object) *objectResource {
+	name := metadata.Name
+	selfLink := baseURL(r) + "/storage/v1/b/" + bucket + "/o/" + url.PathEscape(name)
+	generation := strconv.FormatInt(metadata.Generation, 10)
+
+	var md5Hash string
+	if metadata.MD5Sum != (chunkstore.MD5Hash{}) {
+		md5Hash = base64.StdEncoding.EncodeToString(metadata.MD5Sum[:])
+	}
+
+	var timeDeleted string
+	if !metadata.DeletedAt.IsZero() {
+		timeDeleted = formatTime(metadata.DeletedAt)
+	}
+
+	var customTime string
+	if !metadata.Attrs.CustomTime.IsZero() {
+		customTime = formatTime(metadata.Attrs.CustomTime)
+	}
+
+	var softDeleteTime, hardDeleteTime string
+	if !metadata.SoftDeletedAt.IsZero() {
+		softDeleteTime = formatTime(metadata.SoftDeletedAt)
+		hardDeleteTime = formatTime(metadata.HardDeleteAt)
+	}
+
+	return &objectResource{
+		Kind:               "storage#object",
+		ID:                 bucket + "/" + name + "/" + generation,
+		SelfLink:           selfLink,
+		MediaLink:          baseURL(r) + "/download/storage/v1/b/" + bucket + "/o/" + url.PathEscape(name) + "?generation=" + generation + "&alt=media",
+		Name:               name,
+		Bucket:             bucket,
+		Generation:         metadata.Generation,
+		Metageneration:     metadata.Metageneration,
+		ContentType:        contentType(metadata.Attrs),
+		ContentEncoding:    metadata.Attrs.ContentEncoding,
+		ContentDisposition: metadata.Attrs.ContentDisposition,
+		ContentLanguage:    metadata.Attrs.ContentLanguage,
+		CacheControl:       metadata.Attrs.CacheControl,
+		CustomTime:         customTime,
+		Metadata:           metadata.Attrs.Metadata,
+		StorageClass:       "STANDARD",
+		Size:               metadata.Size,
+		ComponentCount:     metadata.ComponentCount,
+		MD5Hash:            md5Hash,
+		CRC32C:             encodeCRC32C(metadata.CRC32C),
+		ETag:               objectETag(metadata),
+		TimeCreated:        formatTime(metadata.CreatedAt),
+		Updated:            formatTime(metadata.UpdatedAt),
+		TimeDeleted:        timeDeleted,
+		SoftDeleteTime:     softDeleteTime,
+		HardDeleteTime:     hardDeleteTime,
+	}
+}
+
+// objectRequest is an object resource sent by clients when creating an
+// object. Only the writable fields are included.
+type objectRequest struct {
+	Name               string            `json:"name"`
+	ContentType        string            `json:"contentType"`
+	ContentEncoding    string            `json:"contentEncoding"`
+	ContentDisposition string            `json:"contentDisposition"`
+	ContentLanguage    string            `json:"contentLanguage"`
+	CacheControl       string            `json:"cacheControl"`
+	CustomTime         string            `json:"customTime"`
+	Metadata           map[string]string `json:"metadata"`
+	MD5Hash            string            `json:"md5Hash"`
+	CRC32C             string            `json:"crc32c"`
+}
+
+func (req *objectRequest) checksums() (metastore.ExpectedChecksums, error) {
+	return parseChecksums(req.MD5Hash, req.CRC32C)
+}
+
+// hasAttrs reports whether any of the object's attributes are set.
+func (req *objectRequest) hasAttrs() bool {
+	return req.ContentType != "" ||
+		req.ContentEncoding != "" ||
+		req.ContentDisposition != "" ||
+		req.ContentLanguage != "" ||
+		req.CacheControl != "" ||
+		req.CustomTime != "" ||
+		len(req.Metadata) > 0
+}
+
+func (req *objectRequest) attrs() (metastore.ObjectAttrs, error) {
+	customTime, err := parseCustomTime(req.CustomTime)
+	if err != nil {
+		return metastore.ObjectAttrs{}, err
+	}
+
+	return metastore.ObjectAttrs{
+		ContentType:        req.ContentType,
+		ContentEncoding:    req.ContentEncoding,
+		ContentDisposition: req.ContentDisposition,
+		ContentLanguage:    req.ContentLanguage,
+		CacheControl:       req.CacheControl,
+		CustomTime:         customTime,
+		Metadata:           req.Metadata,
+	}, nil
+}
+
+func parseCustomTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	customTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, badRequest("Invalid value for customTime.")
+	}
+	return customTime, nil
+}
+
+// parseObjectUpdate converts the body of a patch or update request to update
+// options. Patches only change the fields present in the body, and a null
+// value clears a field or metadata key. Updates replace every writable field.
+func parseObjectUpdate(req map[string]json.RawMessage, replace bool) (metastore.UpdateObjectOptions, error) {
+	options := metastore.UpdateObjectOptions{ReplaceMetadata: replace}
+
+	var err error
+	options.ContentType, err = parseUpdateString(req, "contentType", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+	options.ContentEncoding, err = parseUpdateString(req, "contentEncoding", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+	options.ContentDisposition, err = parseUpdateString(req, "contentDisposition", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+	options.ContentLanguage, err = parseUpdateString(req, "contentLanguage", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+	options.CacheControl, err = parseUpdateString(req, "cacheControl", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+
+	value, err := parseUpdateString(req, "customTime", replace)
+	if err != nil {
+		return metastore.UpdateObjectOptions{}, err
+	}
+	if value != nil {
+		customTime, err := parseCustomTime(*value)
+		if err != nil {
+			return metastore.UpdateObjectOptions{}, err
+		}
+		options.CustomTime = &customTime
+	}
+
+	if raw, ok := req["metadata"]; ok {
+		err := json.Unmarshal(raw, &options.Metadata)
+		if err != nil {
+			return metastore.UpdateObjectOptions{}, badRequest("Invalid value for metadata.")
+		}
+		if options.Metadata == nil {
+			// A null metadata field clears all metadata.
+			options.ReplaceMetadata = true
+		}
+	}
+
+	return options, nil
+}
+
+// parseUpdateString returns the new value of a string field from the body of a
+// patch or update request, or nil if a patch leaves the field unchanged. Null
+// and missing values clear the field.
+func parseUpdateString(req map[string]json.RawMessage, field string, replace bool) (*string, error) {
+	raw, ok := req[field]
+	if !ok && !replace {
+		return nil, nil
+	}
+
+	var value string
+	if ok {
+		err := json.Unmarshal(raw, &value)
+		if err != nil {
+			return nil, badRequest(fmt.Sprintf("Invalid value for %s.", field))
+		}
+	}
+	return &value, nil
+}
+
+func objectETag(metadata *metastore.Object) string {
+	return base64.StdEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d/%d", metadata.Generation, metadata.Metageneration)),
+	)
+}
+
+func noSuchObject(bucket, name string) error {
+	return notFound(fmt.Sprintf("No such object: %s/%s", bucket, name))
+}
+
+// parseConditions parses the generation and metageneration preconditions from
+// the query parameters. The source conditions of a copy are parsed by passing
+// "Source" as the kind, e.g. for ifSourceGenerationMatch.
+func parseConditions(query url.Values, kind string) (metastore.Conditions, error) {
+	var conditions metastore.Conditions
+	var err error
+	conditions.IfGenerationMatch, err = parseCondition(query, "if"+kind+"GenerationMatch")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	conditions.IfGenerationNotMatch, err = parseCondition(query, "if"+kind+"GenerationNotMatch")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	conditions.IfMetagenerationMatch, err = parseCondition(query, "if"+kind+"MetagenerationMatch")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	conditions.IfMetagenerationNotMatch, err = parseCondition(query, "if"+kind+"MetagenerationNotMatch")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	return conditions, nil
+}
+
+// parseCondition parses a single precondition, which is nil if it isn't set.
+func parseCondition(query url.Values, param string) (*int64, error) {
+	value := query.Get(param)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, badRequest(fmt.Sprintf("Invalid value for %s.", param))
+	}
+	return &parsed, nil
+}
+
+func parseGeneration(query url.Values, param string) (int64, error) {
+	value := query.Get(param)
+	if value == "" {
+		return 0, nil
+	}
+
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, badRequest(fmt.Sprintf("Invalid value for %s.", param))
+	}
+	return generation, nil
+}
+
+// objectHandle returns a handle to the named object, subject to any
+// preconditions and generation in the request.
+func objectHandle(bucket *objectstore.Bucket, name string, r *http.Request) (*objectstore.Object, error) {
+	query := r.URL.Query()
+
+	conditions, err := parseConditions(query, "")
+	if err != nil {
+		return nil, err
+	}
+
+	generation, err := parseGeneration(query, "generation")
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket.Object(name).If(conditions).Generation(generation), nil
+}
+
+func objectMetadata(bucket *objectstore.Bucket, object *objectstore.Object) (*metastore.Object, error) {
+	metadata, err := object.Metadata()
+	if errors.Is(err, metastore.ErrNotExist) {
+		return nil, noSuchObject(bucket.Name(), object.Name())
+	}
+	return metadata, err
+}
+
+type objectsResponse struct {
+	Kind          string            `json:"kind"`
+	Items         []*objectResource `json:"items,omitempty"`
+	Prefixes      []string          `json:"prefixes,omitempty"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	maxResults, err := parseMaxResults(query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	cursor, err := decodePageToken(query.Get("pageToken"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	list, err := bucket.ListObjects(metastore.ListObjectsOptions{
+		Prefix:                   query.Get("prefix"),
+		Delimiter:                query.Get("delimiter"),
+		IncludeTrailingDelimiter: query.Get("includeTrailingDelimiter") == "true",
+		StartOffset:              query.Get("startOffset"),
+		EndOffset:                query.Get("endOffset"),
+		Cursor:                   cursor,
+		MaxResults:               maxResults,
+		Versions:                 query.Get("versions") == "true",
+		SoftDeleted:              query.Get("softDeleted") == "true",
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res := objectsResponse{
+		Kind:          "storage#objects",
+		Prefixes:      list.Prefixes,
+		NextPageToken: encodePageToken(list.NextCursor),
+	}
+	for _, object := range list.Objects {
+		res.Items = append(res.Items, newObjectResource(r, bucket.Name(), object))
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// insertObject creates an empty object from a metadata-only request.
+func (s *Server) insertObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req objectRequest
+	if r.ContentLength != 0 {
+		err = readJSON(r, &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if name := r.URL.Query().Get("name"); name != "" {
+		req.Name = name
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("Required"))
+		return
+	}
+
+	attrs, err := req.attrs()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, req.Name, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writer, err := object.NewWriter()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writer.Attrs = attrs
+
+	err = writer.Close()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), writer.Metadata()))
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("alt") == "media" {
+		s.downloadObject(w, r)
+		return
+	}
+
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := objectMetadata(bucket, object)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err = object.Delete()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(bucket.Name(), object.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateObject handles both objects.patch and objects.update, which change an
+// object's attributes without touching its contents.
+func (s *Server) updateObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req map[string]json.RawMessage
+	err = readJSON(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	options, err := parseObjectUpdate(req, r.Method == http.MethodPut)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := object.Update(options)
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(bucket.Name(), object.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+// composeObject concatenates objects in the bucket into a new object.
+func (s *Server) composeObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req struct {
+		SourceObjects []struct {
+			Name                string `json:"name"`
+			Generation          int64  `json:"generation,string"`
+			ObjectPreconditions struct {
+				IfGenerationMatch *int64 `json:"ifGenerationMatch,string"`
+			} `json:"objectPreconditions"`
+		} `json:"sourceObjects"`
+		Destination *objectRequest `json:"destination"`
+	}
+	err = readJSON(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(req.SourceObjects) == 0 {
+		writeError(w, badRequest("Required: sourceObjects"))
+		return
+	}
+	if len(req.SourceObjects) > metastore.MaxComposeSources {
+		writeError(w, badRequest(fmt.Sprintf(
+			"The number of source components provided (%d) exceeds the maximum (%d)",
+			len(req.SourceObjects), metastore.MaxComposeSources,
+		)))
+		return
+	}
+
+	var attrs metastore.ObjectAttrs
+	if req.Destination != nil {
+		attrs, err = req.Destination.attrs()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	sources := make([]*objectstore.Object, len(req.SourceObjects))
+	for i, source := range req.SourceObjects {
+		if source.Name == "" {
+			writeError(w, badRequest("Required: sourceObjects.name"))
+			return
+		}
+
+		sources[i] = bucket.Object(source.Name).
+			Generation(source.Generation).
+			If(metastore.Conditions{IfGenerationMatch: source.ObjectPreconditions.IfGenerationMatch})
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	composer := object.ComposeFrom(sources...)
+	composer.Attrs = attrs
+
+	metadata, err := composer.Run()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = notFound("Object not found or not accessible: one of the source objects does not exist.")
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+// restoreObject makes a soft-deleted version of an object live again.
+func (s *Server) restoreObject(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if r.URL.Query().Get("generation") == "" {
+		writeError(w, badRequest("Required parameter: generation"))
+		return
+	}
+
+	object, err := objectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := object.Restore()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(bucket.Name(), object.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+// copyRequest is the source and destination of a copy or rewrite request.
+type copyRequest struct {
+	srcBucket  *objectstore.Bucket
+	destBucket *objectstore.Bucket
+	src        *objectstore.Object
+	dest       *objectstore.Object
+	// attrs override the source's attributes if the request body sets any.
+	attrs *metastore.ObjectAttrs
+}
+
+func (s *Server) parseCopyRequest(r *http.Request) (*copyRequest, error) {
+	query := r.URL.Query()
+
+	srcBucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		return nil, err
+	}
+
+	destBucket, err := s.bucket(r.PathValue("destBucket"))
+	if err != nil {
+		return nil, err
+	}
+
+	sourceConditions, err := parseConditions(query, "Source")
+	if err != nil {
+		return nil, err
+	}
+
+	sourceGeneration, err := parseGeneration(query, "sourceGeneration")
+	if err != nil {
+		return nil, err
+	}
+
+	dest, err := objectHandle(destBucket, r.PathValue("destObject"), r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &copyRequest{
+		srcBucket:  srcBucket,
+		destBucket: destBucket,
+		src:        srcBucket.Object(r.PathValue("object")).If(sourceConditions).Generation(sourceGeneration),
+		dest:       dest,
+	}
+
+	if r.ContentLength != 0 {
+		var body objectRequest
+		err = readJSON(r, &body)
+		if err != nil {
+			return nil, err
+		}
+
+		if body.hasAttrs() {
+			attrs, err := body.attrs()
+			if err != nil {
+				return nil, err
+			}
+			req.attrs = &attrs
+		}
+	}
+
+	return req, nil
+}
+
+// copier returns a copier for the request, reading from the given source
+// handle.
+func (req *copyRequest) copier(src *objectstore.Object) *objectstore.Copier {
+	copier := req.dest.CopyFrom(src)
+	copier.Attrs = req.attrs
+	return copier
+}
+
+// copyObject copies an object, which may be a noncurrent version, to a new
+// object. This is how noncurrent versions are restored.
+func (s *Server) copyObject(w http.ResponseWriter, r *http.Request) {
+	req, err := s.parseCopyRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := req.copier(req.src).Run()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(req.srcBucket.Name(), req.src.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, req.destBucket.Name(), metadata))
+}
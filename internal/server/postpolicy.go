@@ -0,0 +1,335 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// HTML forms upload objects with a POST to the bucket, sending the object
+// name, its attributes and a signed policy document as multipart/form-data
+// fields. The policy lists conditions every other field must satisfy, and the
+// file field, which must come last, is only read once they have been checked.
+
+// maxFormFieldSize is the most data read from a form field other than the
+// file.
+const maxFormFieldSize = 64 << 10
+
+var errAccessDenied = &xmlError{http.StatusForbidden, "AccessDenied", "Access denied."}
+
+func invalidPolicyDocument(details string) error {
+	return &detailedXMLError{
+		xmlError: &xmlError{
+			http.StatusBadRequest,
+			"InvalidPolicyDocument",
+			"The content of the form does not meet the conditions specified in the policy document.",
+		},
+		details: details,
+	}
+}
+
+type postPolicyDocument struct {
+	Expiration time.Time         `json:"expiration"`
+	Conditions []json.RawMessage `json:"conditions"`
+}
+
+// postPolicyLimits are the sizes a policy allows the uploaded file to be.
+type postPolicyLimits struct {
+	minSize, maxSize int64
+}
+
+type postResponse struct {
+	XMLName  xml.Name `xml:"PostResponse"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// postPolicyUpload handles an HTML form upload to the bucket in the request
+// path.
+func (s *Server) postPolicyUpload(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeXMLError(w, invalidArgument("POST object expects Content-Type multipart/form-data."))
+		return
+	}
+
+	// Field names are case insensitive.
+	fields := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			writeXMLError(w, invalidArgument("Bucket POST must contain a field named 'file'."))
+			return
+		}
+		if err != nil {
+			writeXMLError(w, invalidArgument("Invalid multipart/form-data body: "+err.Error()))
+			return
+		}
+
+		name := strings.ToLower(part.FormName())
+		if name != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, maxFormFieldSize))
+			if err != nil {
+				writeXMLError(w, invalidArgument("Invalid multipart/form-data body: "+err.Error()))
+				return
+			}
+			fields[name] = string(value)
+			continue
+		}
+
+		// Any fields after the file are ignored.
+		s.postObject(w, r, bucket, fields, part)
+		return
+	}
+}
+
+// postObject checks the form's policy and writes the file to the object named
+// by the key field, where ${filename} is replaced by the file's name. The
+// file's content type is used if the form doesn't set one.
+func (s *Server) postObject(w http.ResponseWriter, r *http.Request, bucket *objectstore.Bucket, fields map[string]string, file *multipart.Part) {
+	if fields["key"] == "" {
+		writeXMLError(w, invalidArgument("Bucket POST must contain a field named 'key'."))
+		return
+	}
+
+	limits, err := s.checkPostPolicy(bucket.Name(), fields, time.Now())
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	// The object's attributes are sent as fields named like the headers of
+	// other XML API requests.
+	header := make(http.Header)
+	for name, value := range fields {
+		header.Set(name, value)
+	}
+	attrs, err := parseXMLAttrs(header)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+	if attrs.ContentType == "" {
+		attrs.ContentType = file.Header.Get("Content-Type")
+	}
+
+	name := strings.ReplaceAll(fields["key"], "${filename}", file.FileName())
+	writer, err := bucket.Object(name).NewWriter()
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+	writer.Attrs = attrs
+
+	// Read one byte more than allowed to find out if the file is too large.
+	n, err := io.Copy(writer, io.LimitReader(file, limits.maxSize+1))
+	switch {
+	case err != nil:
+		err = invalidArgument("Invalid multipart/form-data body: " + err.Error())
+	case n > limits.maxSize:
+		err = &xmlError{http.StatusBadRequest, "EntityTooLarge", "Your proposed upload is larger than the maximum object size specified in your Policy Document."}
+	case n < limits.minSize:
+		err = &xmlError{http.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum object size specified in your Policy Document."}
+	}
+	if err != nil {
+		writer.Abort()
+		writeXMLError(w, err)
+		return
+	}
+
+	err = writer.Close()
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+	metadata := writer.Metadata()
+
+	w.Header().Set("ETag", xmlETag(metadata))
+	w.Header().Set("X-Goog-Generation", strconv.FormatInt(metadata.Generation, 10))
+
+	if redirect := fields["success_action_redirect"]; redirect != "" {
+		target, err := url.Parse(redirect)
+		if err == nil {
+			query := target.Query()
+			query.Set("bucket", bucket.Name())
+			query.Set("key", metadata.Name)
+			query.Set("etag", xmlETag(metadata))
+			target.RawQuery = query.Encode()
+
+			http.Redirect(w, r, target.String(), http.StatusSeeOther)
+			return
+		}
+	}
+
+	switch fields["success_action_status"] {
+	case "200":
+		w.WriteHeader(http.StatusOK)
+	case "201":
+		writeXML(w, http.StatusCreated, postResponse{
+			Location: baseURL(r) + "/" + bucket.Name() + "/" + url.PathEscape(metadata.Name),
+			Bucket:   bucket.Name(),
+			Key:      metadata.Name,
+			ETag:     xmlETag(metadata),
+		})
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// checkPostPolicy verifies the signature of the policy document sent with a
+// form upload and checks the other fields meet its conditions. Forms without
+// a policy are only allowed when signatures aren't verified.
+func (s *Server) checkPostPolicy(bucket string, fields map[string]string, now time.Time) (postPolicyLimits, error) {
+	limits := postPolicyLimits{maxSize: math.MaxInt64 - 1}
+
+	if fields["policy"] == "" {
+		if s.verifiesSignedURLs() {
+			return postPolicyLimits{}, errAccessDenied
+		}
+		return limits, nil
+	}
+
+	if s.verifiesSignedURLs() {
+		err := s.verifyPostPolicySignature(fields)
+		if err != nil {
+			return postPolicyLimits{}, err
+		}
+	}
+
+	data, err := base64.StdEncoding.DecodeString(fields["policy"])
+	if err != nil {
+		return postPolicyLimits{}, invalidPolicyDocument("Policy is not valid base64.")
+	}
+
+	var policy postPolicyDocument
+	err = json.Unmarshal(data, &policy)
+	if err != nil {
+		return postPolicyLimits{}, invalidPolicyDocument("Policy is not valid JSON: " + err.Error())
+	}
+
+	if now.After(policy.Expiration) {
+		return postPolicyLimits{}, invalidPolicyDocument("Policy expired at " + policy.Expiration.Format(time.RFC3339) + ".")
+	}
+
+	// Conditions name fields case insensitively, optionally with a $ prefix.
+	referenced := make(map[string]bool)
+	field := func(name string) string {
+		name = strings.ToLower(strings.TrimPrefix(name, "$"))
+		referenced[name] = true
+		if name == "bucket" {
+			return bucket
+		}
+		return fields[name]
+	}
+
+	for _, raw := range policy.Conditions {
+		var exact map[string]string
+		if json.Unmarshal(raw, &exact) == nil {
+			for name, value := range exact {
+				if field(name) != value {
+					return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Policy did not match condition %s.", raw))
+				}
+			}
+			continue
+		}
+
+		var condition []any
+		err := json.Unmarshal(raw, &condition)
+		if err != nil || len(condition) != 3 {
+			return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Invalid condition %s.", raw))
+		}
+
+		op, _ := condition[0].(string)
+		op = strings.ToLower(op)
+		switch op {
+		case "eq", "starts-with":
+			name, ok1 := condition[1].(string)
+			value, ok2 := condition[2].(string)
+			if !ok1 || !ok2 {
+				return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Invalid condition %s.", raw))
+			}
+
+			actual := field(name)
+			if (op == "eq" && actual != value) || (op != "eq" && !strings.HasPrefix(actual, value)) {
+				return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Policy did not match condition %s.", raw))
+			}
+		case "content-length-range":
+			minSize, ok1 := condition[1].(float64)
+			maxSize, ok2 := condition[2].(float64)
+			if !ok1 || !ok2 || minSize < 0 || maxSize < minSize {
+				return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Invalid condition %s.", raw))
+			}
+			limits = postPolicyLimits{minSize: int64(minSize), maxSize: int64(maxSize)}
+		default:
+			return postPolicyLimits{}, invalidPolicyDocument(fmt.Sprintf("Invalid condition %s.", raw))
+		}
+	}
+
+	// Every field must be covered by a condition, apart from those which
+	// can't be known when the policy is signed.
+	var unreferenced []string
+	for name := range fields {
+		if referenced[name] || name == "policy" || name == "x-goog-signature" || strings.HasPrefix(name, "x-ignore-") {
+			continue
+		}
+		unreferenced = append(unreferenced, name)
+	}
+	if len(unreferenced) > 0 {
+		slices.Sort(unreferenced)
+		return postPolicyLimits{}, invalidPolicyDocument("Policy did not reference these fields: " + strings.Join(unreferenced, ", "))
+	}
+
+	return limits, nil
+}
+
+// verifyPostPolicySignature checks the V4 signature of a policy document,
+// which is signed as is rather than as a canonical request.
+func (s *Server) verifyPostPolicySignature(fields map[string]string) error {
+	for _, name := range []string{"x-goog-algorithm", "x-goog-credential", "x-goog-date", "x-goog-signature"} {
+		if fields[name] == "" {
+			return invalidArgument("Bucket POST with a policy must contain a field named '" + name + "'.")
+		}
+	}
+
+	accessID, scope, ok := parseCredential(fields["x-goog-credential"])
+	if !ok {
+		return invalidArgument("Invalid credential scope " + fields["x-goog-credential"] + ".")
+	}
+
+	signature, err := hex.DecodeString(fields["x-goog-signature"])
+	if err != nil {
+		return invalidArgument("Invalid x-goog-signature.")
+	}
+
+	verify, err := s.signatureVerifier(fields["x-goog-algorithm"], accessID, scope, signature)
+	if err != nil {
+		return err
+	}
+	if !verify(fields["policy"]) {
+		return &detailedXMLError{xmlError: errSignatureDoesNotMatch, stringToSign: fields["policy"]}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+)
+
+// rewriteChunkSize is the unit maxBytesRewrittenPerCall must be a multiple of.
+const rewriteChunkSize = 1 << 20
+
+// rewriteToken tracks the progress of a rewrite across calls. It's handed to
+// clients as an opaque token.
+type rewriteToken struct {
+	Source           string `json:"source"`
+	Destination      string `json:"destination"`
+	SourceGeneration int64  `json:"sourceGeneration"`
+	BytesRewritten   int64  `json:"bytesRewritten"`
+}
+
+func (t *rewriteToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeRewriteToken(value string) (*rewriteToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, badRequest("Invalid rewrite token.")
+	}
+
+	var token rewriteToken
+	err = json.Unmarshal(data, &token)
+	if err != nil {
+		return nil, badRequest("Invalid rewrite token.")
+	}
+	return &token, nil
+}
+
+type rewriteResponse struct {
+	Kind                string          `json:"kind"`
+	TotalBytesRewritten int64           `json:"totalBytesRewritten,string"`
+	ObjectSize          int64           `json:"objectSize,string"`
+	Done                bool            `json:"done"`
+	RewriteToken        string          `json:"rewriteToken,omitempty"`
+	Resource            *objectResource `json:"resource,omitempty"`
+}
+
+// rewriteObject copies an object like copyObject, but may take several calls
+// to do so when maxBytesRewrittenPerCall is set. Chunks are shared with the
+// source so no data is actually rewritten, the progress is only simulated.
+func (s *Server) rewriteObject(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	req, err := s.parseCopyRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var maxBytes int64
+	if value := query.Get("maxBytesRewrittenPerCall"); value != "" {
+		maxBytes, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || maxBytes <= 0 || maxBytes%rewriteChunkSize != 0 {
+			writeError(w, badRequest("maxBytesRewrittenPerCall must be a positive multiple of 1048576."))
+			return
+		}
+	}
+
+	source := req.srcBucket.Name() + "/" + req.src.Name()
+	destination := req.destBucket.Name() + "/" + req.dest.Name()
+
+	// Every call rewrites the generation of the source which was live when the
+	// rewrite started.
+	token := &rewriteToken{Source: source, Destination: destination}
+	if value := query.Get("rewriteToken"); value != "" {
+		token, err = decodeRewriteToken(value)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if token.Source != source || token.Destination != destination {
+			writeError(w, badRequest("Invalid rewrite token."))
+			return
+		}
+	}
+
+	src := req.src
+	if token.SourceGeneration != 0 {
+		src = src.Generation(token.SourceGeneration)
+	}
+	metadata, err := src.Metadata()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(req.srcBucket.Name(), req.src.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	token.SourceGeneration = metadata.Generation
+
+	if maxBytes > 0 && metadata.Size-token.BytesRewritten > maxBytes {
+		token.BytesRewritten += maxBytes
+		writeJSON(w, http.StatusOK, rewriteResponse{
+			Kind:                "storage#rewriteResponse",
+			TotalBytesRewritten: token.BytesRewritten,
+			ObjectSize:          metadata.Size,
+			RewriteToken:        token.encode(),
+		})
+		return
+	}
+
+	metadata, err = req.copier(src.Generation(metadata.Generation)).Run()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = noSuchObject(req.srcBucket.Name(), req.src.Name())
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rewriteResponse{
+		Kind:                "storage#rewriteResponse",
+		TotalBytesRewritten: metadata.Size,
+		ObjectSize:          metadata.Size,
+		Done:                true,
+		Resource:            newObjectResource(r, req.destBucket.Name(), metadata),
+	})
+}
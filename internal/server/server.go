@@ -0,0 +1,251 @@
+package server
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// Server serves the GCS JSON and XML APIs on top of an objectstore.Store.
+type Server struct {
+	store   *objectstore.Store
+	options Options
+	mux     *http.ServeMux
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// Options configure a Server.
+type Options struct {
+	// ServiceAccountKeys are the public keys of service accounts by email,
+	// used to verify GOOG4-RSA-SHA256 signed URLs.
+	ServiceAccountKeys map[string]*rsa.PublicKey
+	// HMACKeys are the secrets of HMAC keys by access ID, used to verify
+	// GOOG4-HMAC-SHA256 signed URLs.
+	HMACKeys map[string]string
+}
+
+func New(store *objectstore.Store) *Server {
+	return NewWithOptions(store, Options{})
+}
+
+// NewWithOptions is like New but allows configuring the keys used to verify
+// signed URLs. Signed URLs are only verified if at least one key is
+// configured.
+func NewWithOptions(store *objectstore.Store, options Options) *Server {
+	s := &Server{
+		store:   store,
+		options: options,
+		mux:     http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /storage/v1/b", s.listBuckets)
+	s.mux.HandleFunc("POST /storage/v1/b", s.insertBucket)
+	s.mux.HandleFunc("GET /storage/v1/b/{bucket}", s.getBucket)
+	s.mux.HandleFunc("PATCH /storage/v1/b/{bucket}", s.patchBucket)
+	s.mux.HandleFunc("DELETE /storage/v1/b/{bucket}", s.deleteBucket)
+
+	s.mux.HandleFunc("GET /storage/v1/b/{bucket}/o", s.listObjects)
+	s.mux.HandleFunc("POST /storage/v1/b/{bucket}/o", s.insertObject)
+	s.mux.HandleFunc("GET /storage/v1/b/{bucket}/o/{object...}", s.getObject)
+	s.mux.HandleFunc("PATCH /storage/v1/b/{bucket}/o/{object...}", s.updateObject)
+	s.mux.HandleFunc("PUT /storage/v1/b/{bucket}/o/{object...}", s.updateObject)
+	s.mux.HandleFunc("DELETE /storage/v1/b/{bucket}/o/{object...}", s.deleteObject)
+	s.mux.HandleFunc("POST /storage/v1/b/{bucket}/o/{object}/compose", s.composeObject)
+	s.mux.HandleFunc("POST /storage/v1/b/{bucket}/o/{object}/restore", s.restoreObject)
+	s.mux.HandleFunc("POST /storage/v1/b/{bucket}/o/{object}/copyTo/b/{destBucket}/o/{destObject}", s.copyObject)
+	s.mux.HandleFunc("POST /storage/v1/b/{bucket}/o/{object}/rewriteTo/b/{destBucket}/o/{destObject}", s.rewriteObject)
+
+	s.mux.HandleFunc("GET /download/storage/v1/b/{bucket}/o/{object...}", s.downloadObject)
+
+	s.mux.HandleFunc("POST /upload/storage/v1/b/{bucket}/o", s.uploadObject)
+	s.mux.HandleFunc("PUT /upload/storage/v1/b/{bucket}/o", s.continueResumableUpload)
+	s.mux.HandleFunc("DELETE /upload/storage/v1/b/{bucket}/o", s.cancelResumableUpload)
+
+	s.mux.HandleFunc("GET /{bucket}", s.listXMLObjects)
+	s.mux.HandleFunc("POST /{bucket}", s.postPolicyUpload)
+	s.mux.HandleFunc("GET /{bucket}/{object...}", s.getXMLObject)
+	s.mux.HandleFunc("PUT /{bucket}/{object...}", s.putXMLObject)
+	s.mux.HandleFunc("POST /{bucket}/{object...}", s.postXMLObject)
+	s.mux.HandleFunc("DELETE /{bucket}/{object...}", s.deleteXMLObject)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.verifiesSignedURLs() && r.URL.Query().Has("X-Goog-Algorithm") {
+		err := s.verifySignedURL(r, time.Now())
+		if err != nil {
+			writeXMLError(w, err)
+			return
+		}
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// httpError is an error with an associated HTTP status and GCS error reason.
+type httpError struct {
+	code    int
+	reason  string
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func badRequest(message string) error {
+	return &httpError{code: http.StatusBadRequest, reason: "invalid", message: message}
+}
+
+func notFound(message string) error {
+	return &httpError{code: http.StatusNotFound, reason: "notFound", message: message}
+}
+
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    int           `json:"code"`
+	Message string        `json:"message"`
+	Errors  []errorDetail `json:"errors"`
+}
+
+type errorDetail struct {
+	Domain  string `json:"domain"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func toHTTPError(err error) *httpError {
+	var httpErr *httpError
+	var preconditionErr *metastore.PreconditionError
+	var checksumErr *objectstore.ChecksumError
+	switch {
+	case errors.As(err, &httpErr):
+		return httpErr
+	case errors.As(err, &preconditionErr):
+		return &httpError{
+			code:    http.StatusPreconditionFailed,
+			reason:  "conditionNotMet",
+			message: "At least one of the pre-conditions you specified did not hold.",
+		}
+	case errors.As(err, &checksumErr):
+		name := map[string]string{"md5": "MD5 hash", "crc32c": "CRC32C"}[checksumErr.Checksum]
+		return &httpError{
+			code:   http.StatusBadRequest,
+			reason: "invalid",
+			message: fmt.Sprintf(
+				"Provided %s %q doesn't match calculated %s %q.",
+				name, base64.StdEncoding.EncodeToString(checksumErr.Provided),
+				name, base64.StdEncoding.EncodeToString(checksumErr.Calculated),
+			),
+		}
+	case errors.Is(err, metastore.ErrNotExist):
+		return &httpError{code: http.StatusNotFound, reason: "notFound", message: "Not Found"}
+	case errors.Is(err, metastore.ErrAlreadyExists):
+		return &httpError{code: http.StatusConflict, reason: "conflict", message: "Already Exists"}
+	case errors.Is(err, metastore.ErrNotEmpty):
+		return &httpError{code: http.StatusConflict, reason: "conflict", message: "The bucket you tried to delete is not empty."}
+	default:
+		log.Printf("internal error: %v", err)
+		return &httpError{code: http.StatusInternalServerError, reason: "backendError", message: err.Error()}
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	httpErr := toHTTPError(err)
+	writeJSON(w, httpErr.code, errorResponse{
+		Error: errorBody{
+			Code:    httpErr.code,
+			Message: httpErr.message,
+			Errors: []errorDetail{{
+				Domain:  "global",
+				Reason:  httpErr.reason,
+				Message: httpErr.message,
+			}},
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Printf("write json response: %v", err)
+	}
+}
+
+func readJSON(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return badRequest("Invalid JSON payload: " + err.Error())
+	}
+	return nil
+}
+
+// formatTime formats timestamps the same way GCS does in resources.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+// baseURL returns the scheme and host the request was addressed to, used to
+// build self links.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// encodePageToken turns a metastore cursor into an opaque page token.
+func encodePageToken(cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodePageToken(token string) (string, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", badRequest("Invalid page token.")
+	}
+	return string(cursor), nil
+}
+
+// parseMaxResults parses the maxResults query parameter, defaulting to and
+// capping at 1000 like GCS does.
+func parseMaxResults(query url.Values) (int, error) {
+	const limit = 1000
+
+	value := query.Get("maxResults")
+	if value == "" {
+		return limit, nil
+	}
+
+	maxResults, err := strconv.Atoi(value)
+	if err != nil || maxResults < 0 {
+		return 0, badRequest("Invalid value for maxResults.")
+	}
+	if maxResults == 0 || maxResults > limit {
+		maxResults = limit
+	}
+	return maxResults, nil
+}
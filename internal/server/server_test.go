@@ -0,0 +1,1525 @@
+package server_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"io"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/shoenig/test/must"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore/file"
+	chunkmemory "github.com/cbrewster/gcs-emulator/internal/chunkstore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore/bolt"
+	metamemory "github.com/cbrewster/gcs-emulator/internal/metastore/memory"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+	"github.com/cbrewster/gcs-emulator/internal/server"
+)
+
+func newFileStore(t *testing.T) chunkstore.Store {
+	dir, err := os.MkdirTemp("", "chunkstore-test-*")
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	store, err := file.New(dir)
+	must.NoError(t, err)
+
+	return store
+}
+
+func newBoltStore(t *testing.T) metastore.Store {
+	dir, err := os.MkdirTemp("", "metastore-test-*")
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	store, err := bolt.New(filepath.Join(dir, "db.bolt"))
+	must.NoError(t, err)
+
+	return store
+}
+
+func newChunkMemoryStore(t *testing.T) chunkstore.Store {
+	return chunkmemory.New()
+}
+
+func newMetaMemoryStore(t *testing.T) metastore.Store {
+	return metamemory.New()
+}
+
+var testCases = []struct {
+	name       string
+	metaStore  func(t *testing.T) metastore.Store
+	chunkStore func(t *testing.T) chunkstore.Store
+}{{
+	name:       "bolt+file",
+	metaStore:  newBoltStore,
+	chunkStore: newFileStore,
+}, {
+	name:       "memory+memory",
+	metaStore:  newMetaMemoryStore,
+	chunkStore: newChunkMemoryStore,
+}}
+
+func newServer(t *testing.T, metaStore metastore.Store, chunkStore chunkstore.Store) (*httptest.Server, *objectstore.Store) {
+	store := objectstore.New(metaStore, chunkStore)
+	srv := httptest.NewServer(server.New(store))
+	t.Cleanup(srv.Close)
+	return srv, store
+}
+
+func writeObject(t *testing.T, bucket *objectstore.Bucket, name string, data []byte) {
+	w, err := bucket.Object(name).NewWriter()
+	must.NoError(t, err)
+	defer w.Close()
+
+	_, err = w.Write(data)
+	must.NoError(t, err)
+
+	err = w.Close()
+	must.NoError(t, err)
+}
+
+func newRequest(t *testing.T, method, url string, body io.Reader) *http.Request {
+	req, err := http.NewRequest(method, url, body)
+	must.NoError(t, err)
+	return req
+}
+
+func do(t *testing.T, method, url string, body io.Reader) *http.Response {
+	return doRequest(t, newRequest(t, method, url, body))
+}
+
+func doRequest(t *testing.T, req *http.Request) *http.Response {
+	res, err := http.DefaultClient.Do(req)
+	must.NoError(t, err)
+	t.Cleanup(func() {
+		res.Body.Close()
+	})
+
+	return res
+}
+
+func decode(t *testing.T, res *http.Response) map[string]any {
+	var v map[string]any
+	err := json.NewDecoder(res.Body).Decode(&v)
+	must.NoError(t, err)
+	return v
+}
+
+func TestBuckets(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b?project=test", strings.NewReader(`{"name":"my-bucket"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "my-bucket", decode(t, res)["name"])
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b?project=test", strings.NewReader(`{"name":"my-bucket"}`))
+			must.Eq(t, http.StatusConflict, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "storage#bucket", decode(t, res)["kind"])
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o?name=foo", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket", nil)
+			must.Eq(t, http.StatusConflict, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/foo", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+func TestObjects(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b", strings.NewReader(`{"name":"my-bucket"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o", strings.NewReader(`{"name":"dir/foo"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			inserted := decode(t, res)
+			must.Eq(t, "dir/foo", inserted["name"])
+			must.Eq(t, "0", inserted["size"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Ffoo", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, inserted["generation"], decode(t, res)["generation"])
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Ffoo", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Ffoo", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Ffoo", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/other-bucket/o/foo", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+func TestDownloadObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			writeObject(t, bucket, "a", []byte("hello "))
+			writeObject(t, bucket, "b", []byte("world"))
+			_, err = bucket.Object("hello").ComposeFrom(bucket.Object("a"), bucket.Object("b")).Run()
+			must.NoError(t, err)
+
+			res := do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/hello?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(body))
+
+			req := newRequest(t, "GET", srv.URL+"/download/storage/v1/b/my-bucket/o/hello?alt=media", nil)
+			req.Header.Set("Range", "bytes=4-7")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusPartialContent, res.StatusCode)
+			must.Eq(t, "bytes 4-7/11", res.Header.Get("Content-Range"))
+			body, err = io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "o wo", string(body))
+
+			req.Header.Set("Range", "bytes=20-")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusRequestedRangeNotSatisfiable, res.StatusCode)
+
+			req.Header.Set("Range", "bytes=6-")
+			req.Header.Set("If-Range", `"stale"`)
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/download/storage/v1/b/my-bucket/o/missing?alt=media", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+func TestResumableUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			res := do(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=resumable", strings.NewReader(`{"name":"big"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			session := res.Header.Get("Location")
+			must.StrContains(t, session, "upload_id=")
+
+			req := newRequest(t, "PUT", session, strings.NewReader("hello "))
+			req.Header.Set("Content-Range", "bytes 0-5/*")
+			res = doRequest(t, req)
+			must.Eq(t, 308, res.StatusCode)
+			must.Eq(t, "bytes=0-5", res.Header.Get("Range"))
+
+			// Resending already persisted bytes should be skipped.
+			req = newRequest(t, "PUT", session, strings.NewReader(" wor"))
+			req.Header.Set("Content-Range", "bytes 5-8/*")
+			res = doRequest(t, req)
+			must.Eq(t, 308, res.StatusCode)
+			must.Eq(t, "bytes=0-8", res.Header.Get("Range"))
+
+			req = newRequest(t, "PUT", session, nil)
+			req.Header.Set("Content-Range", "bytes */*")
+			res = doRequest(t, req)
+			must.Eq(t, 308, res.StatusCode)
+			must.Eq(t, "bytes=0-8", res.Header.Get("Range"))
+
+			// Bodies which don't match the range are rejected before anything
+			// is stored.
+			req = newRequest(t, "PUT", session, strings.NewReader("ld!"))
+			req.Header.Set("Content-Range", "bytes 9-10/11")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			req = newRequest(t, "PUT", session, strings.NewReader("ld!"))
+			req.Header.Set("Content-Range", "bytes 9-11/11")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			req = newRequest(t, "PUT", session, nil)
+			req.Header.Set("Content-Range", "bytes */*")
+			res = doRequest(t, req)
+			must.Eq(t, 308, res.StatusCode)
+			must.Eq(t, "bytes=0-8", res.Header.Get("Range"))
+
+			// A body without a length stops at the end of the range.
+			req = newRequest(t, "PUT", session, io.MultiReader(strings.NewReader("ld!")))
+			req.Header.Set("Content-Range", "bytes 9-10/11")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			finished := decode(t, res)
+			must.Eq(t, "11", finished["size"])
+
+			// Retrying the final request returns the object it created.
+			req = newRequest(t, "PUT", session, strings.NewReader("ld"))
+			req.Header.Set("Content-Range", "bytes 9-10/11")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, finished["generation"], decode(t, res)["generation"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/big?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(body))
+
+			res = do(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=resumable&name=cancelled", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			session = res.Header.Get("Location")
+
+			res = do(t, "DELETE", session, nil)
+			must.Eq(t, 499, res.StatusCode)
+
+			res = do(t, "PUT", session, strings.NewReader("data"))
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+func TestSimpleUploads(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			res := do(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=media&name=simple", strings.NewReader("simple data"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq(t, "simple", object["name"])
+			must.Eq(t, "11", object["size"])
+
+			body := strings.Join([]string{
+				"--boundary",
+				"Content-Type: application/json; charset=UTF-8",
+				"",
+				`{"name":"dir/multi"}`,
+				"--boundary",
+				"Content-Type: text/plain",
+				"",
+				"multipart data",
+				"--boundary--",
+			}, "\r\n")
+			req := newRequest(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=multipart", strings.NewReader(body))
+			req.Header.Set("Content-Type", "multipart/related; boundary=boundary")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object = decode(t, res)
+			must.Eq(t, "dir/multi", object["name"])
+			must.Eq(t, "14", object["size"])
+
+			for name, expected := range map[string]string{"simple": "simple data", "dir%2Fmulti": "multipart data"} {
+				res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/"+name+"?alt=media", nil)
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				read, err := io.ReadAll(res.Body)
+				must.NoError(t, err)
+				must.Eq(t, expected, string(read))
+			}
+
+			req = newRequest(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=multipart", strings.NewReader("garbage"))
+			req.Header.Set("Content-Type", "text/plain")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			for _, name := range []string{"a", "dir/b", "dir/c", "dir/sub/d", "e"} {
+				writeObject(t, bucket, name, []byte(name))
+			}
+
+			res := do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?prefix=dir/&delimiter=/", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			list := decode(t, res)
+			must.SliceLen(t, 2, list["items"].([]any))
+			must.Eq(t, []any{"dir/sub/"}, list["prefixes"].([]any))
+			must.MapNotContainsKey(t, list, "nextPageToken")
+
+			var names []any
+			pageToken := ""
+			for {
+				res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?maxResults=2&pageToken="+pageToken, nil)
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				list = decode(t, res)
+				for _, item := range list["items"].([]any) {
+					names = append(names, item.(map[string]any)["name"])
+				}
+
+				token, ok := list["nextPageToken"]
+				if !ok {
+					break
+				}
+				pageToken = token.(string)
+			}
+			must.Eq(t, []any{"a", "dir/b", "dir/c", "dir/sub/d", "e"}, names)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?pageToken=!!!", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
+
+func TestListBuckets(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			for _, name := range []string{"one", "two", "three"} {
+				res := do(t, "POST", srv.URL+"/storage/v1/b?project=mine", strings.NewReader(`{"name":"`+name+`"}`))
+				must.Eq(t, http.StatusOK, res.StatusCode)
+			}
+			res := do(t, "POST", srv.URL+"/storage/v1/b?project=theirs", strings.NewReader(`{"name":"other"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			var names []any
+			pageToken := ""
+			for {
+				res = do(t, "GET", srv.URL+"/storage/v1/b?project=mine&maxResults=2&pageToken="+pageToken, nil)
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				list := decode(t, res)
+				for _, item := range list["items"].([]any) {
+					names = append(names, item.(map[string]any)["name"])
+				}
+
+				token, ok := list["nextPageToken"]
+				if !ok {
+					break
+				}
+				pageToken = token.(string)
+			}
+			must.Eq(t, []any{"one", "three", "two"}, names)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b?project=mine&prefix=t", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.SliceLen(t, 2, decode(t, res)["items"].([]any))
+		})
+	}
+}
+
+func TestPreconditions(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			upload := srv.URL + "/upload/storage/v1/b/my-bucket/o?uploadType=media&name=lock&ifGenerationMatch=0"
+			res := do(t, "POST", upload, strings.NewReader("leader-1"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			generation := decode(t, res)["generation"].(string)
+
+			res = do(t, "POST", upload, strings.NewReader("leader-2"))
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+			must.Eq(t, "conditionNotMet", decode(t, res)["error"].(map[string]any)["errors"].([]any)[0].(map[string]any)["reason"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/lock?ifGenerationNotMatch="+generation, nil)
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=resumable&name=lock&ifGenerationMatch=0", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			res = do(t, "PUT", res.Header.Get("Location"), strings.NewReader("leader-3"))
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/lock?ifGenerationMatch=1", nil)
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/lock?ifGenerationMatch="+generation, nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/lock?ifGenerationMatch=abc", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
+
+func TestVersioning(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b", strings.NewReader(`{"name":"my-bucket"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq[any](t, map[string]any{"enabled": false}, decode(t, res)["versioning"])
+
+			res = do(t, "PATCH", srv.URL+"/storage/v1/b/my-bucket", strings.NewReader(`{"versioning":{"enabled":true}}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			bucket := decode(t, res)
+			must.Eq[any](t, map[string]any{"enabled": true}, bucket["versioning"])
+			must.Eq(t, "2", bucket["metageneration"])
+
+			upload := srv.URL + "/upload/storage/v1/b/my-bucket/o?uploadType=media&name=config"
+			res = do(t, "POST", upload, strings.NewReader("v1"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			first := decode(t, res)["generation"].(string)
+
+			res = do(t, "POST", upload, strings.NewReader("v2"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/config?alt=media&generation="+first, nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "v1", string(body))
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/config?generation="+first, nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.MapContainsKey(t, decode(t, res), "timeDeleted")
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?versions=true", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.SliceLen(t, 2, decode(t, res)["items"].([]any))
+
+			// Roll back by copying the noncurrent version over the live one.
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/config/copyTo/b/my-bucket/o/config?sourceGeneration="+first, nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/config?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err = io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "v1", string(body))
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/config?generation="+first, nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?versions=true", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.SliceLen(t, 2, decode(t, res)["items"].([]any))
+		})
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, _ := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b", strings.NewReader(`{"name":"my-bucket","softDeletePolicy":{"retentionDurationSeconds":"604800"}}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			policy := decode(t, res)["softDeletePolicy"].(map[string]any)
+			must.Eq[any](t, "604800", policy["retentionDurationSeconds"])
+
+			upload := srv.URL + "/upload/storage/v1/b/my-bucket/o?uploadType=media&name=config"
+			res = do(t, "POST", upload, strings.NewReader("v1"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			generation := decode(t, res)["generation"].(string)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/config", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.MapNotContainsKey(t, decode(t, res), "items")
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?softDeleted=true", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			items := decode(t, res)["items"].([]any)
+			must.SliceLen(t, 1, items)
+			must.MapContainsKey(t, items[0].(map[string]any), "softDeleteTime")
+			must.MapContainsKey(t, items[0].(map[string]any), "hardDeleteTime")
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/config/restore", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/config/restore?generation=1", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/config/restore?generation="+generation, nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.NotEq[any](t, generation, decode(t, res)["generation"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/config?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "v1", string(body))
+
+			res = do(t, "PATCH", srv.URL+"/storage/v1/b/my-bucket", strings.NewReader(`{"softDeletePolicy":{"retentionDurationSeconds":"0"}}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "DELETE", srv.URL+"/storage/v1/b/my-bucket/o/config", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o?softDeleted=true", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.MapNotContainsKey(t, decode(t, res), "items")
+		})
+	}
+}
+
+func TestObjectMetadata(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			body := "--b\r\n" +
+				"Content-Type: application/json\r\n\r\n" +
+				`{"name":"page.html","contentDisposition":"inline","cacheControl":"no-cache","customTime":"2024-01-02T03:04:05Z","metadata":{"owner":"alice"}}` + "\r\n" +
+				"--b\r\n" +
+				"Content-Type: text/html\r\n\r\n" +
+				"<p>hi</p>\r\n" +
+				"--b--\r\n"
+			req := newRequest(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=multipart", strings.NewReader(body))
+			req.Header.Set("Content-Type", "multipart/related; boundary=b")
+			res := doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq[any](t, "text/html", object["contentType"])
+			must.Eq[any](t, "2024-01-02T03:04:05.000Z", object["customTime"])
+			must.Eq[any](t, map[string]any{"owner": "alice"}, object["metadata"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/page.html?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "text/html", res.Header.Get("Content-Type"))
+			must.Eq(t, "inline", res.Header.Get("Content-Disposition"))
+			must.Eq(t, "no-cache", res.Header.Get("Cache-Control"))
+			must.Eq(t, "alice", res.Header.Get("X-Goog-Meta-Owner"))
+
+			res = do(t, "PATCH", srv.URL+"/storage/v1/b/my-bucket/o/page.html?ifMetagenerationMatch=1", strings.NewReader(
+				`{"contentLanguage":"en","cacheControl":null,"metadata":{"owner":null,"team":"storage"}}`,
+			))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object = decode(t, res)
+			must.Eq[any](t, "2", object["metageneration"])
+			must.Eq[any](t, "text/html", object["contentType"])
+			must.Eq[any](t, "en", object["contentLanguage"])
+			must.MapNotContainsKey(t, object, "cacheControl")
+			must.Eq[any](t, map[string]any{"team": "storage"}, object["metadata"])
+
+			res = do(t, "PATCH", srv.URL+"/storage/v1/b/my-bucket/o/page.html?ifMetagenerationMatch=1", strings.NewReader(`{}`))
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "PUT", srv.URL+"/storage/v1/b/my-bucket/o/page.html", strings.NewReader(`{"contentType":"text/plain"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object = decode(t, res)
+			must.Eq[any](t, "3", object["metageneration"])
+			must.Eq[any](t, "text/plain", object["contentType"])
+			must.MapNotContainsKey(t, object, "contentLanguage")
+			must.MapNotContainsKey(t, object, "customTime")
+			must.MapNotContainsKey(t, object, "metadata")
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/page.html?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			data, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "<p>hi</p>", string(data))
+
+			res = do(t, "PATCH", srv.URL+"/storage/v1/b/my-bucket/o/missing", strings.NewReader(`{}`))
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+func TestComposeObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			writeObject(t, bucket, "a", []byte("hello "))
+			writeObject(t, bucket, "b", []byte("world"))
+
+			a, err := bucket.Object("a").Metadata()
+			must.NoError(t, err)
+			generation := strconv.FormatInt(a.Generation, 10)
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose?ifGenerationMatch=0", strings.NewReader(
+				`{"sourceObjects":[{"name":"a","generation":"`+generation+`","objectPreconditions":{"ifGenerationMatch":"`+generation+`"}},{"name":"b"}],"destination":{"contentType":"text/plain"}}`,
+			))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq[any](t, "11", object["size"])
+			must.Eq[any](t, float64(2), object["componentCount"])
+			must.Eq[any](t, "text/plain", object["contentType"])
+			must.MapNotContainsKey(t, object, "md5Hash")
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/hello?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(body))
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose?ifGenerationMatch=0", strings.NewReader(`{"sourceObjects":[{"name":"a"}]}`))
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose", strings.NewReader(`{"sourceObjects":[{"name":"a","objectPreconditions":{"ifGenerationMatch":"1"}}]}`))
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose", strings.NewReader(`{"sourceObjects":[{"name":"missing"}]}`))
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose", strings.NewReader(`{"sourceObjects":[]}`))
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			sources := strings.Repeat(`{"name":"a"},`, metastore.MaxComposeSources) + `{"name":"b"}`
+			res = do(t, "POST", srv.URL+"/storage/v1/b/my-bucket/o/hello/compose", strings.NewReader(`{"sourceObjects":[`+sources+`]}`))
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
+
+func TestChecksums(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			// crc32c and md5 of "hello world".
+			const crc32c = "yZRlqg=="
+			const md5Hash = "XrY7u+Ae7tCTyyK7j1rNww=="
+
+			upload := srv.URL + "/upload/storage/v1/b/my-bucket/o?uploadType=media&name=hello"
+			req := newRequest(t, "POST", upload, strings.NewReader("hello world"))
+			req.Header.Set("X-Goog-Hash", "crc32c="+crc32c+",md5="+md5Hash)
+			res := doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq[any](t, crc32c, object["crc32c"])
+			must.Eq[any](t, md5Hash, object["md5Hash"])
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/hello?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, []string{"crc32c=" + crc32c, "md5=" + md5Hash}, res.Header.Values("X-Goog-Hash"))
+
+			req = newRequest(t, "POST", upload, strings.NewReader("hello world!"))
+			req.Header.Set("X-Goog-Hash", "crc32c="+crc32c)
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			body := "--b\r\n" +
+				"Content-Type: application/json\r\n\r\n" +
+				`{"name":"hello","md5Hash":"` + md5Hash + `"}` + "\r\n" +
+				"--b\r\n\r\n" +
+				"goodbye world\r\n" +
+				"--b--\r\n"
+			req = newRequest(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=multipart", strings.NewReader(body))
+			req.Header.Set("Content-Type", "multipart/related; boundary=b")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			// The object is left untouched by rejected uploads.
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/hello?alt=media", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			data, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(data))
+
+			res = do(t, "POST", srv.URL+"/upload/storage/v1/b/my-bucket/o?uploadType=resumable", strings.NewReader(`{"name":"resumable","crc32c":"`+crc32c+`"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			location := res.Header.Get("Location")
+
+			req = newRequest(t, "PUT", location, strings.NewReader("hello "))
+			req.Header.Set("Content-Range", "bytes 0-5/*")
+			res = doRequest(t, req)
+			must.Eq(t, 308, res.StatusCode)
+
+			req = newRequest(t, "PUT", location, strings.NewReader("world"))
+			req.Header.Set("Content-Range", "bytes 6-10/11")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq[any](t, crc32c, decode(t, res)["crc32c"])
+		})
+	}
+}
+
+func TestCopyObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			src, err := store.CreateBucket("src-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+			dest, err := store.CreateBucket("dest-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			w, err := src.Object("dir/hello").NewWriter()
+			must.NoError(t, err)
+			w.Attrs = metastore.ObjectAttrs{ContentType: "text/plain", Metadata: map[string]string{"foo": "bar"}}
+			_, err = io.WriteString(w, "hello world")
+			must.NoError(t, err)
+			must.NoError(t, w.Close())
+
+			res := do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/dir%2Fhello/copyTo/b/dest-bucket/o/copied", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq[any](t, "dest-bucket", object["bucket"])
+			must.Eq[any](t, "text/plain", object["contentType"])
+			must.Eq[any](t, map[string]any{"foo": "bar"}, object["metadata"])
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/dir%2Fhello/copyTo/b/dest-bucket/o/overridden", strings.NewReader(`{"contentType":"text/html"}`))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object = decode(t, res)
+			must.Eq[any](t, "text/html", object["contentType"])
+			must.MapNotContainsKey(t, object, "metadata")
+
+			// The copies share the source's chunks.
+			for _, name := range []string{"copied", "overridden"} {
+				copied, err := dest.Object(name).Metadata()
+				must.NoError(t, err)
+				must.Eq(t, w.Metadata().Chunks, copied.Chunks)
+			}
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/missing/copyTo/b/dest-bucket/o/copied", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/dir%2Fhello/copyTo/b/dest-bucket/o/copied?ifGenerationMatch=0", nil)
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+		})
+	}
+}
+
+func TestRewriteObject(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			src, err := store.CreateBucket("src-bucket", metastore.NewBucketOptions{Versioning: true})
+			must.NoError(t, err)
+			dest, err := store.CreateBucket("dest-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			data := []byte(strings.Repeat("x", 5<<19))
+			writeObject(t, src, "big", data)
+			original, err := src.Object("big").Metadata()
+			must.NoError(t, err)
+
+			rewrite := srv.URL + "/storage/v1/b/src-bucket/o/big/rewriteTo/b/dest-bucket/o/rewritten?maxBytesRewrittenPerCall=1048576"
+			body := `{"metadata":{"foo":"bar"}}`
+
+			res := do(t, "POST", rewrite, strings.NewReader(body))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			progress := decode(t, res)
+			must.Eq[any](t, false, progress["done"])
+			must.Eq[any](t, "1048576", progress["totalBytesRewritten"])
+			must.Eq[any](t, "2621440", progress["objectSize"])
+
+			// Overwriting the source doesn't affect a rewrite in progress, which
+			// keeps rewriting the now noncurrent version.
+			writeObject(t, src, "big", []byte("replaced"))
+
+			res = do(t, "POST", rewrite+"&rewriteToken="+progress["rewriteToken"].(string), strings.NewReader(body))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			progress = decode(t, res)
+			must.Eq[any](t, false, progress["done"])
+			must.Eq[any](t, "2097152", progress["totalBytesRewritten"])
+
+			res = do(t, "POST", rewrite+"&rewriteToken="+progress["rewriteToken"].(string), strings.NewReader(body))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			progress = decode(t, res)
+			must.Eq[any](t, true, progress["done"])
+			must.Eq[any](t, "2621440", progress["totalBytesRewritten"])
+			resource := progress["resource"].(map[string]any)
+			must.Eq[any](t, "rewritten", resource["name"])
+			must.Eq[any](t, map[string]any{"foo": "bar"}, resource["metadata"])
+
+			rewritten, err := dest.Object("rewritten").Metadata()
+			must.NoError(t, err)
+			must.Eq(t, original.Chunks, rewritten.Chunks)
+			must.Eq(t, original.MD5Sum, rewritten.MD5Sum)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/big/rewriteTo/b/dest-bucket/o/small", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq[any](t, true, decode(t, res)["done"])
+
+			res = do(t, "POST", rewrite+"&rewriteToken=bogus", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			res = do(t, "POST", srv.URL+"/storage/v1/b/src-bucket/o/big/rewriteTo/b/dest-bucket/o/small?maxBytesRewrittenPerCall=1000", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+		})
+	}
+}
+
+func TestTranscoding(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			var compressed bytes.Buffer
+			gz := gzip.NewWriter(&compressed)
+			_, err = io.WriteString(gz, "hello hello hello hello world")
+			must.NoError(t, err)
+			must.NoError(t, gz.Close())
+
+			for name, attrs := range map[string]metastore.ObjectAttrs{
+				"logs.gz":      {ContentType: "text/plain", ContentEncoding: "gzip"},
+				"raw.gz":       {ContentType: "text/plain", ContentEncoding: "gzip", CacheControl: "public, no-transform"},
+				"archive.tgz":  {ContentType: "application/gzip"},
+				"not-gzip.txt": {ContentType: "text/plain", ContentEncoding: "gzip"},
+			} {
+				w, err := bucket.Object(name).NewWriter()
+				must.NoError(t, err)
+				w.Attrs = attrs
+				data := compressed.Bytes()
+				if name == "not-gzip.txt" {
+					data = []byte("plain text")
+				}
+				_, err = w.Write(data)
+				must.NoError(t, err)
+				must.NoError(t, w.Close())
+			}
+
+			download := func(name, acceptEncoding, rangeHeader string) (*http.Response, []byte) {
+				req := newRequest(t, "GET", srv.URL+"/download/storage/v1/b/my-bucket/o/"+name+"?alt=media", nil)
+				// Setting Accept-Encoding explicitly stops the client from
+				// decompressing responses itself.
+				req.Header.Set("Accept-Encoding", acceptEncoding)
+				if rangeHeader != "" {
+					req.Header.Set("Range", rangeHeader)
+				}
+				res := doRequest(t, req)
+				body, err := io.ReadAll(res.Body)
+				must.NoError(t, err)
+				return res, body
+			}
+
+			// Decompressed for clients which don't accept gzip, ignoring Range.
+			for _, rangeHeader := range []string{"", "bytes=0-3"} {
+				res, body := download("logs.gz", "identity", rangeHeader)
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				must.Eq(t, "hello hello hello hello world", string(body))
+				must.Eq(t, "", res.Header.Get("Content-Encoding"))
+				must.Eq(t, "gzip", res.Header.Get("X-Goog-Stored-Content-Encoding"))
+			}
+
+			res, body := download("logs.gz", "gzip;q=0", "")
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "hello hello hello hello world", string(body))
+
+			// Served as stored to clients accepting gzip, honoring Range.
+			res, body = download("logs.gz", "deflate, gzip", "")
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, compressed.Bytes(), body)
+			must.Eq(t, "gzip", res.Header.Get("Content-Encoding"))
+			must.Eq(t, "gzip", res.Header.Get("X-Goog-Stored-Content-Encoding"))
+
+			res, body = download("logs.gz", "gzip", "bytes=0-3")
+			must.Eq(t, http.StatusPartialContent, res.StatusCode)
+			must.Eq(t, compressed.Bytes()[:4], body)
+
+			res, body = download("raw.gz", "identity", "")
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, compressed.Bytes(), body)
+			must.Eq(t, "gzip", res.Header.Get("Content-Encoding"))
+
+			res, body = download("archive.tgz", "identity", "")
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, compressed.Bytes(), body)
+			must.Eq(t, "identity", res.Header.Get("X-Goog-Stored-Content-Encoding"))
+
+			res, body = download("not-gzip.txt", "identity", "")
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "plain text", string(body))
+		})
+	}
+}
+
+func TestXMLAPI(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			req := newRequest(t, "PUT", srv.URL+"/my-bucket/dir/hello.txt", strings.NewReader("hello world"))
+			req.Header.Set("Content-Type", "text/plain")
+			req.Header.Set("Content-MD5", "XrY7u+Ae7tCTyyK7j1rNww==")
+			req.Header.Set("X-Goog-Meta-Foo", "bar")
+			res := doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, `"5eb63bbbe01eeed093cb22bb8f5acdc3"`, res.Header.Get("ETag"))
+			generation := res.Header.Get("X-Goog-Generation")
+
+			// Objects written through the XML API are visible to the JSON API.
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Fhello.txt", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			object := decode(t, res)
+			must.Eq[any](t, "text/plain", object["contentType"])
+			must.Eq[any](t, map[string]any{"foo": "bar"}, object["metadata"])
+			must.Eq[any](t, generation, object["generation"])
+
+			res = do(t, "GET", srv.URL+"/my-bucket/dir/hello.txt", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "hello world", string(body))
+			must.Eq(t, "text/plain", res.Header.Get("Content-Type"))
+			must.Eq(t, "bar", res.Header.Get("X-Goog-Meta-Foo"))
+			must.Eq(t, generation, res.Header.Get("X-Goog-Generation"))
+			must.Eq(t, `"5eb63bbbe01eeed093cb22bb8f5acdc3"`, res.Header.Get("ETag"))
+
+			req = newRequest(t, "GET", srv.URL+"/my-bucket/dir/hello.txt", nil)
+			req.Header.Set("Range", "bytes=6-")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusPartialContent, res.StatusCode)
+			body, err = io.ReadAll(res.Body)
+			must.NoError(t, err)
+			must.Eq(t, "world", string(body))
+
+			res = do(t, "HEAD", srv.URL+"/my-bucket/dir/hello.txt", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "11", res.Header.Get("X-Goog-Stored-Content-Length"))
+
+			req = newRequest(t, "PUT", srv.URL+"/my-bucket/dir/hello.txt", strings.NewReader("goodbye"))
+			req.Header.Set("X-Goog-If-Generation-Match", "0")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusPreconditionFailed, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>PreconditionFailed</Code>")
+
+			req = newRequest(t, "PUT", srv.URL+"/my-bucket/bad", strings.NewReader("goodbye"))
+			req.Header.Set("Content-MD5", "XrY7u+Ae7tCTyyK7j1rNww==")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>BadDigest</Code>")
+
+			// Copies keep the source's metadata unless told to replace it.
+			req = newRequest(t, "PUT", srv.URL+"/my-bucket/copy.txt", nil)
+			req.Header.Set("X-Goog-Copy-Source", "my-bucket/dir/hello.txt")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<ETag>&#34;5eb63bbbe01eeed093cb22bb8f5acdc3&#34;</ETag>")
+
+			res = do(t, "HEAD", srv.URL+"/my-bucket/copy.txt", nil)
+			must.Eq(t, "bar", res.Header.Get("X-Goog-Meta-Foo"))
+
+			req = newRequest(t, "PUT", srv.URL+"/my-bucket/dir/sub/replaced.txt", nil)
+			req.Header.Set("X-Goog-Copy-Source", "/my-bucket/dir%2Fhello.txt")
+			req.Header.Set("X-Goog-Metadata-Directive", "REPLACE")
+			req.Header.Set("Content-Type", "text/html")
+			res = doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "HEAD", srv.URL+"/my-bucket/dir/sub/replaced.txt", nil)
+			must.Eq(t, "text/html", res.Header.Get("Content-Type"))
+			must.Eq(t, "", res.Header.Get("X-Goog-Meta-Foo"))
+
+			list := func(query string) xmlListBucketResult {
+				res := do(t, "GET", srv.URL+"/my-bucket?"+query, nil)
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				var result xmlListBucketResult
+				must.NoError(t, xml.NewDecoder(res.Body).Decode(&result))
+				return result
+			}
+
+			result := list("")
+			must.Eq(t, []string{"copy.txt", "dir/hello.txt", "dir/sub/replaced.txt"}, result.keys())
+			must.False(t, result.IsTruncated)
+
+			result = list("prefix=dir/&delimiter=/")
+			must.Eq(t, []string{"dir/hello.txt"}, result.keys())
+			must.Eq(t, []string{"dir/sub/"}, result.prefixes())
+
+			// Pages resume from the marker, skipping rolled up prefixes.
+			result = list("delimiter=/&max-keys=1")
+			must.Eq(t, []string{"copy.txt"}, result.keys())
+			must.True(t, result.IsTruncated)
+			must.Eq(t, "copy.txt", result.NextMarker)
+
+			result = list("delimiter=/&max-keys=1&marker=" + result.NextMarker)
+			must.Eq(t, []string{"dir/"}, result.prefixes())
+
+			result = list("delimiter=/&marker=dir/")
+			must.Eq(t, 0, len(result.Contents)+len(result.CommonPrefixes))
+
+			res = do(t, "DELETE", srv.URL+"/my-bucket/copy.txt", nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+
+			res = do(t, "GET", srv.URL+"/my-bucket/copy.txt", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>NoSuchKey</Code>")
+
+			res = do(t, "GET", srv.URL+"/missing-bucket?prefix=dir", nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>NoSuchBucket</Code>")
+		})
+	}
+}
+
+type xmlListBucketResult struct {
+	IsTruncated bool
+	NextMarker  string
+	Contents    []struct {
+		Key string
+	}
+	CommonPrefixes []struct {
+		Prefix string
+	}
+}
+
+func (r *xmlListBucketResult) keys() []string {
+	var keys []string
+	for _, contents := range r.Contents {
+		keys = append(keys, contents.Key)
+	}
+	return keys
+}
+
+func (r *xmlListBucketResult) prefixes() []string {
+	var prefixes []string
+	for _, prefix := range r.CommonPrefixes {
+		prefixes = append(prefixes, prefix.Prefix)
+	}
+	return prefixes
+}
+
+func readBody(t *testing.T, res *http.Response) string {
+	body, err := io.ReadAll(res.Body)
+	must.NoError(t, err)
+	return string(body)
+}
+
+func TestXMLMultipartUpload(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+
+			_, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			req := newRequest(t, "POST", srv.URL+"/my-bucket/dir/big.txt?uploads", nil)
+			req.Header.Set("Content-Type", "text/plain")
+			req.Header.Set("X-Goog-Meta-Foo", "bar")
+			res := doRequest(t, req)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			var initiated struct {
+				Bucket   string
+				Key      string
+				UploadID string `xml:"UploadId"`
+			}
+			must.NoError(t, xml.NewDecoder(res.Body).Decode(&initiated))
+			must.Eq(t, "my-bucket", initiated.Bucket)
+			must.Eq(t, "dir/big.txt", initiated.Key)
+			uploadURL := srv.URL + "/my-bucket/dir/big.txt?uploadId=" + initiated.UploadID
+
+			// Every part but the last must be at least the minimum size.
+			hello := strings.Repeat("hello ", metastore.MinUploadPartSize/6+1)
+
+			etags := make(map[int]string)
+			for number, data := range map[int]string{2: "world", 1: hello} {
+				res = do(t, "PUT", uploadURL+"&partNumber="+strconv.Itoa(number), strings.NewReader(data))
+				must.Eq(t, http.StatusOK, res.StatusCode)
+				etags[number] = res.Header.Get("ETag")
+			}
+			must.Eq(t, `"5d41402abc4b2a76b9719d911017c592"`, do(t, "PUT", uploadURL+"&partNumber=3", strings.NewReader("hello")).Header.Get("ETag"))
+
+			res = do(t, "PUT", uploadURL+"&partNumber=0", strings.NewReader("nope"))
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+
+			res = do(t, "GET", uploadURL+"&max-parts=2", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			var parts struct {
+				IsTruncated          bool
+				NextPartNumberMarker int
+				Part                 []struct {
+					PartNumber int
+					ETag       string
+					Size       int64
+				}
+			}
+			must.NoError(t, xml.NewDecoder(res.Body).Decode(&parts))
+			must.True(t, parts.IsTruncated)
+			must.Eq(t, 2, parts.NextPartNumberMarker)
+			must.SliceLen(t, 2, parts.Part)
+			must.Eq(t, etags[1], parts.Part[0].ETag)
+			must.Eq(t, 5, parts.Part[1].Size)
+
+			complete := func(body string) *http.Response {
+				return do(t, "POST", uploadURL, strings.NewReader(body))
+			}
+
+			res = complete(`<CompleteMultipartUpload><Part><PartNumber>2</PartNumber><ETag>` + etags[2] + `</ETag></Part><Part><PartNumber>1</PartNumber><ETag>` + etags[1] + `</ETag></Part></CompleteMultipartUpload>`)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>InvalidPartOrder</Code>")
+
+			res = complete(`<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>` + etags[2] + `</ETag></Part></CompleteMultipartUpload>`)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>InvalidPart</Code>")
+
+			res = complete(`<CompleteMultipartUpload><Part><PartNumber>2</PartNumber><ETag>` + etags[2] + `</ETag></Part><Part><PartNumber>3</PartNumber><ETag>"5d41402abc4b2a76b9719d911017c592"</ETag></Part></CompleteMultipartUpload>`)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>EntityTooSmall</Code>")
+
+			// Part 3 was uploaded but is left out of the object.
+			res = complete(`<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>` + etags[1] + `</ETag></Part><Part><PartNumber>2</PartNumber><ETag>` + etags[2] + `</ETag></Part></CompleteMultipartUpload>`)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Key>dir/big.txt</Key>")
+
+			res = do(t, "GET", srv.URL+"/my-bucket/dir/big.txt", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, hello+"world", readBody(t, res))
+			must.Eq(t, "text/plain", res.Header.Get("Content-Type"))
+			must.Eq(t, "bar", res.Header.Get("X-Goog-Meta-Foo"))
+
+			res = do(t, "GET", srv.URL+"/storage/v1/b/my-bucket/o/dir%2Fbig.txt", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq[any](t, float64(2), decode(t, res)["componentCount"])
+
+			res = do(t, "GET", uploadURL, nil)
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>NoSuchUpload</Code>")
+
+			// Aborted uploads are gone.
+			res = do(t, "POST", srv.URL+"/my-bucket/aborted?uploads", nil)
+			must.NoError(t, xml.NewDecoder(res.Body).Decode(&initiated))
+			res = do(t, "DELETE", srv.URL+"/my-bucket/aborted?uploadId="+initiated.UploadID, nil)
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+			res = do(t, "PUT", srv.URL+"/my-bucket/aborted?partNumber=1&uploadId="+initiated.UploadID, strings.NewReader("late"))
+			must.Eq(t, http.StatusNotFound, res.StatusCode)
+		})
+	}
+}
+
+// hmacSign returns the hex GOOG4-HMAC-SHA256 signature of stringToSign.
+func hmacSign(secret, scope, stringToSign string) string {
+	key := []byte("GOOG4" + secret)
+	for _, part := range append(strings.Split(scope, "/"), stringToSign) {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+	return hex.EncodeToString(key)
+}
+
+// signHMAC signs a URL with a GOOG4-HMAC-SHA256 signature covering only the
+// host header.
+func signHMAC(t *testing.T, method, rawURL, accessID, secret string, date time.Time, expires time.Duration) string {
+	u, err := url.Parse(rawURL)
+	must.NoError(t, err)
+
+	scope := date.Format("20060102") + "/auto/storage/goog4_request"
+	query := u.Query()
+	query.Set("X-Goog-Algorithm", "GOOG4-HMAC-SHA256")
+	query.Set("X-Goog-Credential", accessID+"/"+scope)
+	query.Set("X-Goog-Date", date.Format("20060102T150405Z"))
+	query.Set("X-Goog-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Goog-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		strings.ReplaceAll(query.Encode(), "+", "%20"),
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"GOOG4-HMAC-SHA256", query.Get("X-Goog-Date"), scope, hex.EncodeToString(hash[:])}, "\n")
+
+	query.Set("X-Goog-Signature", hmacSign(secret, scope, stringToSign))
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func TestSignedURLs(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	must.NoError(t, err)
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	const serviceAccount = "signer@test.iam.gserviceaccount.com"
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
+			srv := httptest.NewServer(server.NewWithOptions(store, server.Options{
+				ServiceAccountKeys: map[string]*rsa.PublicKey{serviceAccount: &privateKey.PublicKey},
+				HMACKeys:           map[string]string{"GOOG1TEST": "secret"},
+			}))
+			t.Cleanup(srv.Close)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+			writeObject(t, bucket, "dir/hello world.txt", []byte("hello"))
+
+			signURL := func(method, object string) string {
+				signed, err := storage.SignedURL("my-bucket", object, &storage.SignedURLOptions{
+					GoogleAccessID: serviceAccount,
+					PrivateKey:     privateKeyPEM,
+					Method:         method,
+					Expires:        time.Now().Add(time.Hour),
+					Scheme:         storage.SigningSchemeV4,
+					Style:          storage.PathStyle(),
+					Hostname:       strings.TrimPrefix(srv.URL, "http://"),
+					Insecure:       true,
+				})
+				must.NoError(t, err)
+				return signed
+			}
+
+			res := do(t, "GET", signURL("GET", "dir/hello world.txt"), nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "hello", readBody(t, res))
+
+			res = do(t, "PUT", signURL("PUT", "uploaded"), strings.NewReader("uploaded"))
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			// The signature doesn't cover a different method or object.
+			res = do(t, "DELETE", signURL("GET", "uploaded"), nil)
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>SignatureDoesNotMatch</Code>")
+
+			tampered := strings.Replace(signURL("GET", "uploaded"), "/uploaded?", "/dir/hello%20world.txt?", 1)
+			res = do(t, "GET", tampered, nil)
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<CanonicalRequest>")
+
+			other := strings.Replace(signURL("GET", "uploaded"), "signer%40", "other%40", 1)
+			res = do(t, "GET", other, nil)
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>InvalidAccessKeyId</Code>")
+
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "secret", time.Now().UTC(), time.Minute), nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+			must.Eq(t, "uploaded", readBody(t, res))
+
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "wrong", time.Now().UTC(), time.Minute), nil)
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "secret", time.Now().UTC().Add(-time.Hour), time.Minute), nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>ExpiredToken</Code>")
+
+			// Dates slightly ahead of the server's clock are allowed.
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "secret", time.Now().UTC().Add(30*time.Second), time.Minute), nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "secret", time.Now().UTC().Add(time.Hour), time.Minute), nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>RequestNotYetValid</Code>")
+
+			res = do(t, "GET", signHMAC(t, "GET", srv.URL+"/my-bucket/uploaded", "GOOG1TEST", "secret", time.Now().UTC(), 8*24*time.Hour), nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>AuthorizationQueryParametersError</Code>")
+
+			res = do(t, "GET", srv.URL+"/my-bucket/uploaded?X-Goog-Algorithm=GOOG4-RSA-SHA256", nil)
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>AuthorizationQueryParametersError</Code>")
+
+			// Requests which aren't signed are still served.
+			res = do(t, "GET", srv.URL+"/my-bucket/uploaded", nil)
+			must.Eq(t, http.StatusOK, res.StatusCode)
+		})
+	}
+}
+
+// postForm uploads file with an HTML form containing fields.
+func postForm(t *testing.T, url string, fields map[string]string, file string) *http.Response {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range fields {
+		must.NoError(t, form.WriteField(name, value))
+	}
+	part, err := form.CreateFormFile("file", "hello.txt")
+	must.NoError(t, err)
+	_, err = part.Write([]byte(file))
+	must.NoError(t, err)
+	must.NoError(t, form.Close())
+
+	req := newRequest(t, "POST", url, &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return doRequest(t, req)
+}
+
+func TestPostPolicyUpload(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	must.NoError(t, err)
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	const serviceAccount = "signer@test.iam.gserviceaccount.com"
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := objectstore.New(tc.metaStore(t), tc.chunkStore(t))
+			srv := httptest.NewServer(server.NewWithOptions(store, server.Options{
+				ServiceAccountKeys: map[string]*rsa.PublicKey{serviceAccount: &privateKey.PublicKey},
+				HMACKeys:           map[string]string{"GOOG1TEST": "secret"},
+			}))
+			t.Cleanup(srv.Close)
+
+			bucket, err := store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+
+			policy, err := storage.GenerateSignedPostPolicyV4("my-bucket", "uploads/${filename}", &storage.PostPolicyV4Options{
+				GoogleAccessID: serviceAccount,
+				PrivateKey:     privateKeyPEM,
+				Expires:        time.Now().Add(time.Hour),
+				Conditions: []storage.PostPolicyV4Condition{
+					storage.ConditionStartsWith("$key", "uploads/"),
+					storage.ConditionContentLengthRange(1, 10),
+				},
+				Fields: &storage.PolicyV4Fields{
+					ContentType:         "text/plain",
+					Metadata:            map[string]string{"x-goog-meta-foo": "bar"},
+					StatusCodeOnSuccess: 201,
+				},
+				Style:    storage.PathStyle(),
+				Hostname: strings.TrimPrefix(srv.URL, "http://"),
+				Insecure: true,
+			})
+			must.NoError(t, err)
+
+			res := postForm(t, policy.URL, policy.Fields, "hello")
+			must.Eq(t, http.StatusCreated, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Key>uploads/hello.txt</Key>")
+
+			metadata, err := bucket.Object("uploads/hello.txt").Metadata()
+			must.NoError(t, err)
+			must.Eq(t, 5, metadata.Size)
+			must.Eq(t, "text/plain", metadata.Attrs.ContentType)
+			must.Eq(t, map[string]string{"foo": "bar"}, metadata.Attrs.Metadata)
+
+			res = postForm(t, policy.URL, policy.Fields, "hello world")
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>EntityTooLarge</Code>")
+
+			res = postForm(t, policy.URL, policy.Fields, "")
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>EntityTooSmall</Code>")
+
+			// Fields must match the policy's conditions.
+			fields := maps.Clone(policy.Fields)
+			fields["content-type"] = "text/html"
+			res = postForm(t, policy.URL, fields, "hello")
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>InvalidPolicyDocument</Code>")
+
+			fields = maps.Clone(policy.Fields)
+			fields["cache-control"] = "no-cache"
+			res = postForm(t, policy.URL, fields, "hello")
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "Policy did not reference these fields: cache-control")
+
+			fields = maps.Clone(policy.Fields)
+			fields["x-ignore-tracking"] = "1"
+			res = postForm(t, policy.URL, fields, "hello")
+			must.Eq(t, http.StatusCreated, res.StatusCode)
+
+			// The policy can't be changed without invalidating the signature.
+			fields = maps.Clone(policy.Fields)
+			fields["policy"] = base64.StdEncoding.EncodeToString([]byte(`{"expiration":"2100-01-01T00:00:00Z","conditions":[]}`))
+			res = postForm(t, policy.URL, fields, "hello")
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "<Code>SignatureDoesNotMatch</Code>")
+
+			fields = maps.Clone(policy.Fields)
+			delete(fields, "policy")
+			res = postForm(t, policy.URL, fields, "hello")
+			must.Eq(t, http.StatusForbidden, res.StatusCode)
+
+			// Policies can also be signed with HMAC keys.
+			signHMACPolicy := func(expiration time.Time) map[string]string {
+				date := time.Now().UTC()
+				scope := date.Format("20060102") + "/auto/storage/goog4_request"
+				fields := map[string]string{
+					"key":               "hmac.txt",
+					"x-goog-algorithm":  "GOOG4-HMAC-SHA256",
+					"x-goog-credential": "GOOG1TEST/" + scope,
+					"x-goog-date":       date.Format("20060102T150405Z"),
+				}
+
+				conditions := []any{map[string]string{"bucket": "my-bucket"}}
+				for name, value := range fields {
+					conditions = append(conditions, map[string]string{name: value})
+				}
+				document, err := json.Marshal(map[string]any{
+					"expiration": expiration.Format(time.RFC3339),
+					"conditions": conditions,
+				})
+				must.NoError(t, err)
+
+				fields["policy"] = base64.StdEncoding.EncodeToString(document)
+				fields["x-goog-signature"] = hmacSign("secret", scope, fields["policy"])
+				return fields
+			}
+
+			res = postForm(t, srv.URL+"/my-bucket", signHMACPolicy(time.Now().Add(time.Hour)), "hello")
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+			_, err = bucket.Object("hmac.txt").Metadata()
+			must.NoError(t, err)
+
+			res = postForm(t, srv.URL+"/my-bucket", signHMACPolicy(time.Now().Add(-time.Hour)), "hello")
+			must.Eq(t, http.StatusBadRequest, res.StatusCode)
+			must.StrContains(t, readBody(t, res), "Policy expired")
+
+			// Without any keys configured, forms don't need a policy.
+			unsigned, store := newServer(t, tc.metaStore(t), tc.chunkStore(t))
+			_, err = store.CreateBucket("my-bucket", metastore.NewBucketOptions{})
+			must.NoError(t, err)
+			res = postForm(t, unsigned.URL+"/my-bucket", map[string]string{"key": "${filename}"}, "hello")
+			must.Eq(t, http.StatusNoContent, res.StatusCode)
+			res = do(t, "GET", unsigned.URL+"/my-bucket/hello.txt", nil)
+			must.Eq(t, "hello", readBody(t, res))
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// statusResumeIncomplete is returned by GCS while a resumable upload is still
+// waiting for more data.
+const statusResumeIncomplete = http.StatusPermanentRedirect
+
+// statusClientClosedRequest is returned by GCS when a resumable upload has
+// been cancelled.
+const statusClientClosedRequest = 499
+
+func (s *Server) uploadObject(w http.ResponseWriter, r *http.Request) {
+	switch uploadType := r.URL.Query().Get("uploadType"); uploadType {
+	case "media":
+		s.mediaUpload(w, r)
+	case "multipart":
+		s.multipartUpload(w, r)
+	case "resumable":
+		s.startResumableUpload(w, r)
+	default:
+		writeError(w, badRequest(fmt.Sprintf("Unsupported upload type %q.", uploadType)))
+	}
+}
+
+// writeObject streams the contents of r into a new object. The object is only
+// created if its contents match checksums.
+func writeObject(
+	object *objectstore.Object,
+	attrs metastore.ObjectAttrs,
+	checksums metastore.ExpectedChecksums,
+	r io.Reader,
+) (*metastore.Object, error) {
+	writer, err := object.NewWriter()
+	if err != nil {
+		return nil, err
+	}
+	writer.Attrs = attrs
+	writer.Checksums = checksums
+
+	_, err = io.Copy(writer, r)
+	if err != nil {
+		writer.Abort()
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return writer.Metadata(), nil
+}
+
+// mediaUpload handles simple uploads where the request body is the object's
+// contents.
+func (s *Server) mediaUpload(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeError(w, badRequest("Required"))
+		return
+	}
+
+	object, err := objectHandle(bucket, name, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	checksums, err := parseHashHeader(r.Header)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs := metastore.ObjectAttrs{ContentType: r.Header.Get("Content-Type")}
+	metadata, err := writeObject(object, attrs, checksums, r.Body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+// multipartUpload handles multipart/related uploads where the first part is
+// the object's JSON metadata and the second part is its contents. The media
+// part is streamed directly into the object.
+func (s *Server) multipartUpload(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		writeError(w, badRequest("Multipart uploads require a multipart/related Content-Type with a boundary."))
+		return
+	}
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	metadataPart, err := reader.NextPart()
+	if err != nil {
+		writeError(w, badRequest("Missing metadata part: "+err.Error()))
+		return
+	}
+
+	var req objectRequest
+	err = json.NewDecoder(metadataPart).Decode(&req)
+	if err != nil {
+		writeError(w, badRequest("Invalid JSON payload: "+err.Error()))
+		return
+	}
+	if name := r.URL.Query().Get("name"); name != "" {
+		req.Name = name
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("Required"))
+		return
+	}
+
+	attrs, err := req.attrs()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	checksums, err := req.checksums()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	mediaPart, err := reader.NextPart()
+	if err != nil {
+		writeError(w, badRequest("Missing media part: "+err.Error()))
+		return
+	}
+	if attrs.ContentType == "" {
+		attrs.ContentType = mediaPart.Header.Get("Content-Type")
+	}
+
+	object, err := objectHandle(bucket, req.Name, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := writeObject(object, attrs, checksums, mediaPart)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, bucket.Name(), metadata))
+}
+
+func (s *Server) startResumableUpload(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.bucket(r.PathValue("bucket"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req objectRequest
+	if r.ContentLength != 0 {
+		err = readJSON(r, &req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	if name := r.URL.Query().Get("name"); name != "" {
+		req.Name = name
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("Required"))
+		return
+	}
+
+	attrs, err := req.attrs()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if attrs.ContentType == "" {
+		attrs.ContentType = r.Header.Get("X-Upload-Content-Type")
+	}
+
+	checksums, err := req.checksums()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	object, err := objectHandle(bucket, req.Name, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	upload, err := object.NewUpload(attrs, checksums)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	query := url.Values{}
+	query.Set("uploadType", "resumable")
+	query.Set("upload_id", upload.ID())
+	w.Header().Set("Location", baseURL(r)+"/upload/storage/v1/b/"+bucket.Name()+"/o?"+query.Encode())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) resumableUpload(r *http.Request) (*objectstore.Upload, error) {
+	id := r.URL.Query().Get("upload_id")
+	if id == "" {
+		return nil, badRequest("Missing upload_id.")
+	}
+
+	upload, err := s.store.Upload(id)
+	if errors.Is(err, metastore.ErrNotExist) || (err == nil && upload.Bucket() != r.PathValue("bucket")) {
+		return nil, notFound("No such upload.")
+	}
+	return upload, err
+}
+
+// contentRange is a parsed Content-Range header as sent by resumable upload
+// clients, e.g. "bytes 0-99/*", "bytes 100-199/200" or "bytes */200".
+type contentRange struct {
+	// hasData is false when the header is of the form "bytes */total".
+	hasData bool
+	start   int64
+	end     int64
+	// total is the total size of the object, or -1 if not yet known.
+	total int64
+}
+
+func parseContentRange(header string) (contentRange, error) {
+	cr := contentRange{total: -1}
+
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return cr, badRequest("Invalid Content-Range header.")
+	}
+
+	byteRange, total, ok := strings.Cut(spec, "/")
+	if !ok {
+		return cr, badRequest("Invalid Content-Range header.")
+	}
+
+	if total != "*" {
+		var err error
+		cr.total, err = strconv.ParseInt(total, 10, 64)
+		if err != nil || cr.total < 0 {
+			return cr, badRequest("Invalid Content-Range header.")
+		}
+	}
+
+	if byteRange == "*" {
+		return cr, nil
+	}
+
+	start, end, ok := strings.Cut(byteRange, "-")
+	if !ok {
+		return cr, badRequest("Invalid Content-Range header.")
+	}
+
+	var err error
+	cr.start, err = strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return cr, badRequest("Invalid Content-Range header.")
+	}
+	cr.end, err = strconv.ParseInt(end, 10, 64)
+	if err != nil || cr.end < cr.start {
+		return cr, badRequest("Invalid Content-Range header.")
+	}
+	cr.hasData = true
+
+	return cr, nil
+}
+
+// continueResumableUpload handles uploading data to, querying the status of
+// and finalizing a resumable upload.
+func (s *Server) continueResumableUpload(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.resumableUpload(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Clients retrying the request which finished the upload, or querying its
+	// status afterwards, get the object it created.
+	if metadata := upload.Finished(); metadata != nil {
+		writeJSON(w, http.StatusOK, newObjectResource(r, upload.Bucket(), metadata))
+		return
+	}
+
+	cr := contentRange{hasData: true, total: -1}
+	if header := r.Header.Get("Content-Range"); header != "" {
+		cr, err = parseContentRange(header)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	} else {
+		// Without a Content-Range the body is the entire object.
+		cr.start = upload.Size()
+	}
+
+	if cr.hasData {
+		if cr.start > upload.Size() {
+			writeError(w, badRequest(fmt.Sprintf(
+				"Invalid request. The upload has %d bytes persisted but the request starts at byte %d.",
+				upload.Size(), cr.start,
+			)))
+			return
+		}
+
+		body := io.Reader(r.Body)
+		if r.Header.Get("Content-Range") != "" {
+			if r.ContentLength >= 0 && r.ContentLength != cr.end+1-cr.start {
+				writeError(w, badRequest(fmt.Sprintf(
+					"Invalid request. The Content-Range header covers %d bytes but the request has %d bytes.",
+					cr.end+1-cr.start, r.ContentLength,
+				)))
+				return
+			}
+			if cr.total >= 0 && cr.end >= cr.total {
+				writeError(w, badRequest(fmt.Sprintf(
+					"Invalid request. The Content-Range header ends at byte %d which exceeds the declared size of %d bytes.",
+					cr.end, cr.total,
+				)))
+				return
+			}
+
+			// Bodies sent without a length may run past the end of the range,
+			// which must not end up in the session.
+			body = io.LimitReader(r.Body, cr.end+1-cr.start)
+		}
+
+		// Clients may resend data which has already been persisted, skip over it.
+		_, err = io.CopyN(io.Discard, body, upload.Size()-cr.start)
+		if err != nil && !errors.Is(err, io.EOF) {
+			writeError(w, err)
+			return
+		}
+
+		_, err = upload.Append(body)
+		if errors.Is(err, metastore.ErrConflict) {
+			err = &httpError{code: http.StatusConflict, reason: "conflict", message: "Concurrent upload to the same session."}
+		}
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if r.Header.Get("Content-Range") == "" {
+			cr.total = upload.Size()
+		}
+	}
+
+	if cr.total >= 0 && upload.Size() > cr.total {
+		writeError(w, badRequest(fmt.Sprintf(
+			"Invalid request. The upload has %d bytes which exceeds the declared size of %d bytes.",
+			upload.Size(), cr.total,
+		)))
+		return
+	}
+
+	if cr.total < 0 || upload.Size() < cr.total {
+		if upload.Size() > 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", upload.Size()-1))
+		}
+		w.Header().Set("Content-Length", "0")
+		w.WriteHeader(statusResumeIncomplete)
+		return
+	}
+
+	// Clients may send the checksums of the whole object with the last
+	// request.
+	checksums, err := parseHashHeader(r.Header)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	metadata, err := upload.Finish(checksums)
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = notFound("The specified bucket does not exist.")
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newObjectResource(r, upload.Bucket(), metadata))
+}
+
+func (s *Server) cancelResumableUpload(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.resumableUpload(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	err = upload.Cancel()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(statusClientClosedRequest)
+}
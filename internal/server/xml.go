@@ -0,0 +1,533 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// The XML API serves buckets and objects at /{bucket} and /{bucket}/{object},
+// the same paths as storage.googleapis.com. It shares the store with the JSON
+// API, so both see the same objects.
+
+// xmlNamespace is the namespace of XML API responses, inherited from S3.
+const xmlNamespace = "http://doc.s3.amazonaws.com/2006-03-01"
+
+// xmlError is an error with an associated HTTP status and XML API error code,
+// e.g. "NoSuchKey".
+type xmlError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *xmlError) Error() string {
+	return e.message
+}
+
+// detailedXMLError is an XML API error with extra details, such as why a
+// signed request was rejected.
+type detailedXMLError struct {
+	*xmlError
+	details          string
+	stringToSign     string
+	canonicalRequest string
+}
+
+func (e *detailedXMLError) Unwrap() error {
+	return e.xmlError
+}
+
+var (
+	errNoSuchBucket = &xmlError{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist."}
+	errNoSuchKey    = &xmlError{http.StatusNotFound, "NoSuchKey", "The specified key does not exist."}
+)
+
+func invalidArgument(message string) error {
+	return &xmlError{http.StatusBadRequest, "InvalidArgument", message}
+}
+
+func toXMLError(err error) *xmlError {
+	var xmlErr *xmlError
+	var checksumErr *objectstore.ChecksumError
+	switch {
+	case errors.As(err, &xmlErr):
+		return xmlErr
+	case errors.As(err, &checksumErr):
+		name := map[string]string{"md5": "MD5", "crc32c": "CRC32C"}[checksumErr.Checksum]
+		return &xmlError{http.StatusBadRequest, "BadDigest", fmt.Sprintf("The %s you specified did not match what we received.", name)}
+	case errors.Is(err, metastore.ErrNotExist):
+		return errNoSuchKey
+	case errors.Is(err, metastore.ErrNotEmpty):
+		return &xmlError{http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty."}
+	}
+
+	// Fall back to the JSON API's mapping for everything else.
+	httpErr := toHTTPError(err)
+	code, ok := map[int]string{
+		http.StatusBadRequest:         "InvalidArgument",
+		http.StatusNotFound:           "NotFound",
+		http.StatusConflict:           "Conflict",
+		http.StatusPreconditionFailed: "PreconditionFailed",
+	}[httpErr.code]
+	if !ok {
+		code = "InternalError"
+	}
+	return &xmlError{httpErr.code, code, httpErr.message}
+}
+
+type xmlErrorResponse struct {
+	XMLName          xml.Name `xml:"Error"`
+	Code             string   `xml:"Code"`
+	Message          string   `xml:"Message"`
+	Details          string   `xml:"Details,omitempty"`
+	StringToSign     string   `xml:"StringToSign,omitempty"`
+	CanonicalRequest string   `xml:"CanonicalRequest,omitempty"`
+}
+
+func writeXMLError(w http.ResponseWriter, err error) {
+	xmlErr := toXMLError(err)
+	res := xmlErrorResponse{Code: xmlErr.code, Message: xmlErr.message}
+
+	var detailedErr *detailedXMLError
+	if errors.As(err, &detailedErr) {
+		res.Details = detailedErr.details
+		res.StringToSign = detailedErr.stringToSign
+		res.CanonicalRequest = detailedErr.canonicalRequest
+	}
+
+	writeXML(w, xmlErr.status, res)
+}
+
+func writeXML(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/xml; charset=UTF-8")
+	w.WriteHeader(code)
+
+	_, err := w.Write([]byte(xml.Header))
+	if err == nil {
+		err = xml.NewEncoder(w).Encode(v)
+	}
+	if err != nil {
+		log.Printf("write xml response: %v", err)
+	}
+}
+
+// xmlETag returns the ETag of an object in the XML API, which is the hex MD5
+// of its contents unless the object is composite.
+func xmlETag(metadata *metastore.Object) string {
+	if metadata.MD5Sum == (chunkstore.MD5Hash{}) {
+		return `"` + objectETag(metadata) + `"`
+	}
+	return `"` + hex.EncodeToString(metadata.MD5Sum[:]) + `"`
+}
+
+func (s *Server) xmlBucket(name string) (*objectstore.Bucket, error) {
+	bucket, err := s.store.Bucket(name)
+	if errors.Is(err, metastore.ErrNotExist) {
+		return nil, errNoSuchBucket
+	}
+	return bucket, err
+}
+
+// parseXMLConditions parses the preconditions of an XML API request, which
+// are sent as x-goog-if-*-match headers.
+func parseXMLConditions(header http.Header) (metastore.Conditions, error) {
+	var conditions metastore.Conditions
+	var err error
+	conditions.IfGenerationMatch, err = parseXMLCondition(header, "X-Goog-If-Generation-Match")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	conditions.IfMetagenerationMatch, err = parseXMLCondition(header, "X-Goog-If-Metageneration-Match")
+	if err != nil {
+		return metastore.Conditions{}, err
+	}
+	return conditions, nil
+}
+
+// parseXMLCondition parses a single precondition header, which is nil if it
+// isn't set.
+func parseXMLCondition(header http.Header, name string) (*int64, error) {
+	value := header.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, invalidArgument(fmt.Sprintf("Invalid value for %s.", strings.ToLower(name)))
+	}
+	return &parsed, nil
+}
+
+// xmlObjectHandle returns a handle to the named object, subject to any
+// preconditions and generation in the request.
+func xmlObjectHandle(bucket *objectstore.Bucket, name string, r *http.Request) (*objectstore.Object, error) {
+	conditions, err := parseXMLConditions(r.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	var generation int64
+	if value := r.URL.Query().Get("generation"); value != "" {
+		generation, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, invalidArgument("Invalid value for generation.")
+		}
+	}
+
+	return bucket.Object(name).If(conditions).Generation(generation), nil
+}
+
+// parseXMLAttrs reads the attributes of an object being written from the
+// request headers.
+func parseXMLAttrs(header http.Header) (metastore.ObjectAttrs, error) {
+	attrs := metastore.ObjectAttrs{
+		ContentType:        header.Get("Content-Type"),
+		ContentEncoding:    header.Get("Content-Encoding"),
+		ContentDisposition: header.Get("Content-Disposition"),
+		ContentLanguage:    header.Get("Content-Language"),
+		CacheControl:       header.Get("Cache-Control"),
+	}
+
+	customTime, err := parseCustomTime(header.Get("X-Goog-Custom-Time"))
+	if err != nil {
+		return metastore.ObjectAttrs{}, invalidArgument("Invalid value for x-goog-custom-time.")
+	}
+	attrs.CustomTime = customTime
+
+	for key, values := range header {
+		name, ok := strings.CutPrefix(key, "X-Goog-Meta-")
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if attrs.Metadata == nil {
+			attrs.Metadata = make(map[string]string)
+		}
+		attrs.Metadata[strings.ToLower(name)] = values[0]
+	}
+
+	return attrs, nil
+}
+
+// parseXMLChecksums parses the checksums of an object being written from the
+// Content-MD5 and x-goog-hash headers.
+func parseXMLChecksums(header http.Header) (metastore.ExpectedChecksums, error) {
+	checksums, err := parseHashHeader(header)
+	if err != nil {
+		return metastore.ExpectedChecksums{}, &xmlError{http.StatusBadRequest, "InvalidDigest", err.Error()}
+	}
+
+	if value := header.Get("Content-MD5"); value != "" {
+		md5Checksums, err := parseChecksums(value, "")
+		if err != nil {
+			return metastore.ExpectedChecksums{}, &xmlError{http.StatusBadRequest, "InvalidDigest", "The Content-MD5 you specified was invalid."}
+		}
+		checksums.MD5 = md5Checksums.MD5
+	}
+
+	return checksums, nil
+}
+
+func (s *Server) getXMLObject(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("object") == "" {
+		s.listXMLObjects(w, r)
+		return
+	}
+	if r.URL.Query().Has("uploadId") {
+		s.listParts(w, r)
+		return
+	}
+
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	object, err := xmlObjectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	reader, err := object.NewReader()
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+	defer reader.Close()
+
+	metadata := reader.Metadata()
+
+	setObjectHeaders(w.Header(), metadata)
+	w.Header().Set("ETag", xmlETag(metadata))
+
+	serveObject(w, r, reader)
+}
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"CopyObjectResult"`
+	LastModified string   `xml:"LastModified"`
+	ETag         string   `xml:"ETag"`
+}
+
+// putXMLObject creates an object from the request body, or copies another
+// object if x-goog-copy-source is set.
+func (s *Server) putXMLObject(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("uploadId") {
+		s.uploadPart(w, r)
+		return
+	}
+
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	if r.PathValue("object") == "" {
+		writeXMLError(w, invalidArgument("Object name must not be empty."))
+		return
+	}
+
+	object, err := xmlObjectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	attrs, err := parseXMLAttrs(r.Header)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	if r.Header.Get("X-Goog-Copy-Source") != "" {
+		s.copyXMLObject(w, r, object, attrs)
+		return
+	}
+
+	checksums, err := parseXMLChecksums(r.Header)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	metadata, err := writeObject(object, attrs, checksums, r.Body)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	header := w.Header()
+	header.Set("ETag", xmlETag(metadata))
+	header.Set("X-Goog-Generation", strconv.FormatInt(metadata.Generation, 10))
+	header.Set("X-Goog-Metageneration", strconv.FormatInt(metadata.Metageneration, 10))
+	header.Add("X-Goog-Hash", "crc32c="+encodeCRC32C(metadata.CRC32C))
+	header.Add("X-Goog-Hash", "md5="+base64.StdEncoding.EncodeToString(metadata.MD5Sum[:]))
+	w.WriteHeader(http.StatusOK)
+}
+
+// copyXMLObject copies the object named by x-goog-copy-source to dest. The
+// source's attributes are kept unless x-goog-metadata-directive is REPLACE.
+func (s *Server) copyXMLObject(w http.ResponseWriter, r *http.Request, dest *objectstore.Object, attrs metastore.ObjectAttrs) {
+	source, err := url.PathUnescape(r.Header.Get("X-Goog-Copy-Source"))
+	if err != nil {
+		writeXMLError(w, invalidArgument("Invalid value for x-goog-copy-source."))
+		return
+	}
+
+	srcBucketName, srcName, ok := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+	if !ok || srcName == "" {
+		writeXMLError(w, invalidArgument("Invalid value for x-goog-copy-source."))
+		return
+	}
+
+	srcBucket, err := s.xmlBucket(srcBucketName)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	var generation int64
+	if value := r.Header.Get("X-Goog-Copy-Source-Generation"); value != "" {
+		generation, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			writeXMLError(w, invalidArgument("Invalid value for x-goog-copy-source-generation."))
+			return
+		}
+	}
+
+	copier := dest.CopyFrom(srcBucket.Object(srcName).Generation(generation))
+	switch directive := r.Header.Get("X-Goog-Metadata-Directive"); directive {
+	case "", "COPY":
+	case "REPLACE":
+		copier.Attrs = &attrs
+	default:
+		writeXMLError(w, invalidArgument(fmt.Sprintf("Invalid value for x-goog-metadata-directive: %q.", directive)))
+		return
+	}
+
+	metadata, err := copier.Run()
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	w.Header().Set("X-Goog-Generation", strconv.FormatInt(metadata.Generation, 10))
+	writeXML(w, http.StatusOK, copyObjectResult{
+		LastModified: formatTime(metadata.UpdatedAt),
+		ETag:         xmlETag(metadata),
+	})
+}
+
+func (s *Server) deleteXMLObject(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Has("uploadId") {
+		s.abortMultipartUpload(w, r)
+		return
+	}
+
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	object, err := xmlObjectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	err = object.Delete()
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name             `xml:"ListBucketResult"`
+	Xmlns          string               `xml:"xmlns,attr"`
+	Name           string               `xml:"Name"`
+	Prefix         string               `xml:"Prefix"`
+	Marker         string               `xml:"Marker"`
+	NextMarker     string               `xml:"NextMarker,omitempty"`
+	Delimiter      string               `xml:"Delimiter,omitempty"`
+	MaxKeys        int                  `xml:"MaxKeys"`
+	IsTruncated    bool                 `xml:"IsTruncated"`
+	Contents       []listBucketContents `xml:"Contents"`
+	CommonPrefixes []commonPrefix       `xml:"CommonPrefixes"`
+}
+
+type listBucketContents struct {
+	Key            string `xml:"Key"`
+	Generation     int64  `xml:"Generation"`
+	MetaGeneration int64  `xml:"MetaGeneration"`
+	LastModified   string `xml:"LastModified"`
+	ETag           string `xml:"ETag"`
+	Size           int64  `xml:"Size"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// listXMLObjects lists the objects in a bucket. Listings resume after the
+// marker, which is the last key or common prefix of the previous page.
+func (s *Server) listXMLObjects(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	maxKeys := 1000
+	if value := query.Get("max-keys"); value != "" {
+		maxKeys, err = strconv.Atoi(value)
+		if err != nil || maxKeys < 0 {
+			writeXMLError(w, invalidArgument("Invalid value for max-keys."))
+			return
+		}
+		maxKeys = min(maxKeys, 1000)
+	}
+
+	res := listBucketResult{
+		Xmlns:     xmlNamespace,
+		Name:      bucket.Name(),
+		Prefix:    query.Get("prefix"),
+		Marker:    query.Get("marker"),
+		Delimiter: query.Get("delimiter"),
+		MaxKeys:   maxKeys,
+	}
+	if maxKeys == 0 {
+		writeXML(w, http.StatusOK, res)
+		return
+	}
+
+	options := metastore.ListObjectsOptions{
+		Prefix:     res.Prefix,
+		Delimiter:  res.Delimiter,
+		MaxResults: maxKeys,
+	}
+	if res.Marker != "" {
+		// Start just after the marker, skipping everything rolled up into it
+		// if it's a common prefix.
+		options.StartOffset = res.Marker + "\x00"
+		if res.Delimiter != "" && strings.HasSuffix(res.Marker, res.Delimiter) {
+			options.StartOffset = metastore.PrefixEnd(res.Marker)
+			if options.StartOffset == "" {
+				writeXML(w, http.StatusOK, res)
+				return
+			}
+		}
+	}
+
+	list, err := bucket.ListObjects(options)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	for _, object := range list.Objects {
+		res.Contents = append(res.Contents, listBucketContents{
+			Key:            object.Name,
+			Generation:     object.Generation,
+			MetaGeneration: object.Metageneration,
+			LastModified:   formatTime(object.UpdatedAt),
+			ETag:           xmlETag(object),
+			Size:           object.Size,
+		})
+	}
+	for _, prefix := range list.Prefixes {
+		res.CommonPrefixes = append(res.CommonPrefixes, commonPrefix{Prefix: prefix})
+	}
+
+	if list.NextCursor != "" {
+		res.IsTruncated = true
+		if len(list.Objects) > 0 {
+			res.NextMarker = list.Objects[len(list.Objects)-1].Name
+		}
+		if len(list.Prefixes) > 0 {
+			res.NextMarker = max(res.NextMarker, list.Prefixes[len(list.Prefixes)-1])
+		}
+	}
+
+	writeXML(w, http.StatusOK, res)
+}
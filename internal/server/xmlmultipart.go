@@ -0,0 +1,305 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/metastore"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+)
+
+// XML API multipart uploads are addressed by the object's path with an
+// uploadId query parameter. Each part is stored as chunks in the upload, and
+// completing the upload assembles the object from them without copying.
+
+// maxPartNumber is the largest part number GCS allows.
+const maxPartNumber = 10000
+
+var (
+	errNoSuchUpload = &xmlError{http.StatusNotFound, "NoSuchUpload", "The requested upload was not found."}
+	errInvalidPart  = &xmlError{http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found."}
+)
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+type listPartsResult struct {
+	XMLName              xml.Name   `xml:"ListPartsResult"`
+	Xmlns                string     `xml:"xmlns,attr"`
+	Bucket               string     `xml:"Bucket"`
+	Key                  string     `xml:"Key"`
+	UploadID             string     `xml:"UploadId"`
+	PartNumberMarker     int        `xml:"PartNumberMarker"`
+	NextPartNumberMarker int        `xml:"NextPartNumberMarker,omitempty"`
+	MaxParts             int        `xml:"MaxParts"`
+	IsTruncated          bool       `xml:"IsTruncated"`
+	Parts                []partInfo `xml:"Part"`
+}
+
+type partInfo struct {
+	PartNumber   int    `xml:"PartNumber"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+}
+
+func partETag(part *metastore.UploadPart) string {
+	return `"` + hex.EncodeToString(part.MD5Sum[:]) + `"`
+}
+
+func (s *Server) postXMLObject(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case r.PathValue("object") == "":
+		s.postPolicyUpload(w, r)
+	case query.Has("uploads"):
+		s.initiateMultipartUpload(w, r)
+	case query.Has("uploadId"):
+		s.completeMultipartUpload(w, r)
+	default:
+		writeXMLError(w, invalidArgument("POST requires either uploads or uploadId."))
+	}
+}
+
+// xmlMultipartUpload looks up the upload named by the uploadId query
+// parameter, which must be for the object in the request path.
+func (s *Server) xmlMultipartUpload(r *http.Request) (*objectstore.MultipartUpload, error) {
+	upload, err := s.store.MultipartUpload(r.URL.Query().Get("uploadId"))
+	if errors.Is(err, metastore.ErrNotExist) {
+		return nil, errNoSuchUpload
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.Bucket() != r.PathValue("bucket") || upload.Object() != r.PathValue("object") {
+		return nil, errNoSuchUpload
+	}
+
+	return upload, nil
+}
+
+func (s *Server) initiateMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	bucket, err := s.xmlBucket(r.PathValue("bucket"))
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	if r.PathValue("object") == "" {
+		writeXMLError(w, invalidArgument("Object name must not be empty."))
+		return
+	}
+
+	object, err := xmlObjectHandle(bucket, r.PathValue("object"), r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	attrs, err := parseXMLAttrs(r.Header)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	upload, err := object.NewMultipartUpload(attrs)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	writeXML(w, http.StatusOK, initiateMultipartUploadResult{
+		Xmlns:    xmlNamespace,
+		Bucket:   upload.Bucket(),
+		Key:      upload.Object(),
+		UploadID: upload.ID(),
+	})
+}
+
+func (s *Server) uploadPart(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.xmlMultipartUpload(r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		writeXMLError(w, invalidArgument("Part number must be an integer between 1 and 10000, inclusive."))
+		return
+	}
+
+	checksums, err := parseXMLChecksums(r.Header)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	part, err := upload.PutPart(number, r.Body, checksums)
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = errNoSuchUpload
+	}
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	w.Header().Set("ETag", partETag(part))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) completeMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.xmlMultipartUpload(r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	var req completeMultipartUpload
+	err = xml.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		writeXMLError(w, &xmlError{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema."})
+		return
+	}
+
+	parts := make([]objectstore.CompletedPart, len(req.Parts))
+	for i, part := range req.Parts {
+		md5Sum, err := hex.DecodeString(strings.Trim(part.ETag, `"`))
+		if err != nil || len(md5Sum) != len(chunkstore.MD5Hash{}) {
+			writeXMLError(w, errInvalidPart)
+			return
+		}
+		parts[i] = objectstore.CompletedPart{Number: part.PartNumber, MD5Sum: chunkstore.MD5Hash(md5Sum)}
+	}
+
+	metadata, err := upload.Complete(parts)
+	switch {
+	case errors.Is(err, metastore.ErrInvalidPart):
+		err = errInvalidPart
+	case errors.Is(err, metastore.ErrInvalidPartOrder):
+		err = &xmlError{http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order."}
+	case errors.Is(err, metastore.ErrPartTooSmall):
+		err = &xmlError{http.StatusBadRequest, "EntityTooSmall", "Your proposed upload is smaller than the minimum allowed object size."}
+	case errors.Is(err, metastore.ErrNotExist):
+		err = errNoSuchUpload
+	}
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	w.Header().Set("X-Goog-Generation", strconv.FormatInt(metadata.Generation, 10))
+	writeXML(w, http.StatusOK, completeMultipartUploadResult{
+		Xmlns:    xmlNamespace,
+		Location: baseURL(r) + r.URL.Path,
+		Bucket:   upload.Bucket(),
+		Key:      upload.Object(),
+		ETag:     xmlETag(metadata),
+	})
+}
+
+func (s *Server) abortMultipartUpload(w http.ResponseWriter, r *http.Request) {
+	upload, err := s.xmlMultipartUpload(r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	err = upload.Abort()
+	if errors.Is(err, metastore.ErrNotExist) {
+		err = errNoSuchUpload
+	}
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listParts lists the parts uploaded so far, resuming after the
+// part-number-marker.
+func (s *Server) listParts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	upload, err := s.xmlMultipartUpload(r)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	res := listPartsResult{
+		Xmlns:    xmlNamespace,
+		Bucket:   upload.Bucket(),
+		Key:      upload.Object(),
+		UploadID: upload.ID(),
+		MaxParts: 1000,
+	}
+
+	for param, field := range map[string]*int{
+		"max-parts":          &res.MaxParts,
+		"part-number-marker": &res.PartNumberMarker,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			writeXMLError(w, invalidArgument("Invalid value for "+param+"."))
+			return
+		}
+		*field = parsed
+	}
+	res.MaxParts = min(res.MaxParts, 1000)
+
+	for _, part := range upload.Metadata().Parts {
+		if part.Number <= res.PartNumberMarker {
+			continue
+		}
+		if len(res.Parts) >= res.MaxParts {
+			res.IsTruncated = true
+			break
+		}
+
+		res.Parts = append(res.Parts, partInfo{
+			PartNumber:   part.Number,
+			LastModified: formatTime(part.UpdatedAt),
+			ETag:         partETag(&part),
+			Size:         part.Size,
+		})
+	}
+	if res.IsTruncated && len(res.Parts) > 0 {
+		res.NextPartNumberMarker = res.Parts[len(res.Parts)-1].PartNumber
+	}
+
+	writeXML(w, http.StatusOK, res)
+}
@@ -1,7 +1,196 @@
 package main
 
-import "fmt"
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cbrewster/gcs-emulator/internal/chunker"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore"
+	"github.com/cbrewster/gcs-emulator/internal/chunkstore/file"
+	"github.com/cbrewster/gcs-emulator/internal/grpcserver"
+	"github.com/cbrewster/gcs-emulator/internal/metastore/bolt"
+	"github.com/cbrewster/gcs-emulator/internal/objectstore"
+	"github.com/cbrewster/gcs-emulator/internal/server"
+)
 
 func main() {
-	fmt.Println("hello")
+	addr := flag.String("addr", "localhost:9023", "address to listen on")
+	grpcAddr := flag.String("grpc-addr", "", "address to serve the gRPC API on, empty disables it")
+	dataDir := flag.String("data-dir", "data", "directory to persist buckets and objects in")
+	gcInterval := flag.Duration("gc-interval", 10*time.Minute, "how often to delete unreferenced chunks, 0 disables garbage collection")
+	gcGrace := flag.Duration("gc-grace", time.Hour, "minimum age of unreferenced chunks before they are deleted")
+	partialMaxAge := flag.Duration("partial-max-age", 24*time.Hour, "how long partially written chunks are kept without being written to, 0 disables cleanup")
+	chunkSize := flag.Int("chunk-size", 0, "split objects into fixed-size chunks of this many bytes, 0 uses content-defined chunking")
+	prefetch := flag.Int("prefetch", objectstore.DefaultOptions.Prefetch, "number of chunks to read ahead concurrently when reading objects")
+
+	serverOptions := server.Options{
+		ServiceAccountKeys: map[string]*rsa.PublicKey{},
+		HMACKeys:           map[string]string{},
+	}
+	flag.Func("service-account-key", "service account JSON key file whose signed URLs are verified, may be repeated", func(path string) error {
+		email, key, err := loadServiceAccountKey(path)
+		if err != nil {
+			return err
+		}
+		serverOptions.ServiceAccountKeys[email] = key
+		return nil
+	})
+	flag.Func("hmac-key", "HMAC key as ACCESS_ID:SECRET whose signed URLs are verified, may be repeated", func(value string) error {
+		accessID, secret, ok := strings.Cut(value, ":")
+		if !ok || accessID == "" || secret == "" {
+			return errors.New("must be ACCESS_ID:SECRET")
+		}
+		serverOptions.HMACKeys[accessID] = secret
+		return nil
+	})
+	flag.Parse()
+
+	options := objectstore.DefaultOptions
+	options.Prefetch = *prefetch
+	if *chunkSize != 0 {
+		options.Chunking = chunker.FixedSize(*chunkSize)
+	}
+
+	err := run(*addr, *grpcAddr, *dataDir, *gcInterval, *gcGrace, *partialMaxAge, options, serverOptions)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(addr, grpcAddr, dataDir string, gcInterval, gcGrace, partialMaxAge time.Duration, options objectstore.Options, serverOptions server.Options) error {
+	err := options.Chunking.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid chunking options: %w", err)
+	}
+
+	err = os.MkdirAll(dataDir, 0755)
+	if err != nil {
+		return fmt.Errorf("make data dir: %w", err)
+	}
+
+	metaStore, err := bolt.New(filepath.Join(dataDir, "metadata.bolt"))
+	if err != nil {
+		return fmt.Errorf("open metastore: %w", err)
+	}
+
+	chunkStore, err := file.New(dataDir)
+	if err != nil {
+		return fmt.Errorf("open chunkstore: %w", err)
+	}
+
+	store := objectstore.NewWithOptions(metaStore, chunkStore, options)
+
+	if gcInterval > 0 {
+		go collectGarbage(store, gcInterval, gcGrace)
+	}
+	if partialMaxAge > 0 {
+		go removePartialChunks(chunkStore, partialMaxAge)
+	}
+
+	errs := make(chan error, 2)
+	if grpcAddr != "" {
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return fmt.Errorf("listen for grpc: %w", err)
+		}
+
+		log.Printf("serving grpc on %s", grpcAddr)
+		go func() {
+			errs <- grpcserver.New(store).Serve(lis)
+		}()
+	}
+
+	log.Printf("listening on %s", addr)
+	go func() {
+		errs <- http.ListenAndServe(addr, server.NewWithOptions(store, serverOptions))
+	}()
+
+	return <-errs
+}
+
+// loadServiceAccountKey reads the email and public key of a service account
+// from a JSON key file, as downloaded from the cloud console.
+func loadServiceAccountKey(path string) (string, *rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var keyFile struct {
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}
+	err = json.Unmarshal(data, &keyFile)
+	if err != nil {
+		return "", nil, fmt.Errorf("parse key file: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(keyFile.PrivateKey))
+	if block == nil || keyFile.ClientEmail == "" {
+		return "", nil, errors.New("key file has no client_email or private_key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return "", nil, errors.New("private key is not an RSA key")
+	}
+
+	return keyFile.ClientEmail, &rsaKey.PublicKey, nil
+}
+
+func collectGarbage(store *objectstore.Store, interval, grace time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := store.CollectGarbage(grace)
+		if err != nil {
+			log.Printf("collect garbage: %v", err)
+		}
+		if deleted > 0 {
+			log.Printf("deleted %d unreferenced chunks", deleted)
+		}
+
+		stats := store.ChunkStats()
+		if stats.Bytes > 0 {
+			log.Printf("wrote %d bytes in %d chunks, dedup ratio %.2f", stats.Bytes, stats.Chunks, stats.DedupRatio())
+		}
+	}
+}
+
+// removePartialChunks periodically cleans up chunks which were abandoned part
+// way through being written.
+func removePartialChunks(chunkStore chunkstore.Store, maxAge time.Duration) {
+	ticker := time.NewTicker(min(maxAge, time.Hour))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		removed, err := chunkStore.RemovePartial(maxAge)
+		if err != nil {
+			log.Printf("remove partial chunks: %v", err)
+		}
+		if removed > 0 {
+			log.Printf("removed %d partial chunks", removed)
+		}
+	}
 }